@@ -0,0 +1,276 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// AccessTokensService handles communication with the HTTP access tokens related
+// methods of the Bitbucket Server API. Tokens can be personal, or scoped to a
+// project or a repository.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-access-tokens-rest.html
+type AccessTokensService service
+
+// AccessToken represents an HTTP access token.
+type AccessToken struct {
+	ID                string   `json:"id,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Permissions       []string `json:"permissions,omitempty"`
+	CreatedDate       Time     `json:"createdDate,omitempty"`
+	LastAuthenticated Time     `json:"lastAuthenticated,omitempty"`
+	ExpiryDays        int      `json:"expiryDays,omitempty"`
+	ExpiryDate        Time     `json:"expiryDate,omitempty"`
+	User              *User    `json:"user,omitempty"`
+
+	// Token the secret of the token, this populated only when the token is created.
+	Token string `json:"token,omitempty"`
+}
+
+// AccessTokenRequest is the payload used to create or update an access token.
+type AccessTokenRequest struct {
+	Name string `json:"name,omitempty"`
+
+	// Permissions the permissions granted by the token, e.g. PermissionRepoRead and PermissionProjectWrite.
+	Permissions []string `json:"permissions,omitempty"`
+
+	// ExpiryDays (optional) the number of days until the token expires, it can
+	// be set only when the token is created.
+	ExpiryDays int `json:"expiryDays,omitempty"`
+}
+
+// VerifyAccessTokenFunc verifies a newly created access token before the old one
+// is revoked, e.g. by deploying it and checking the deployment works.
+type VerifyAccessTokenFunc func(ctx context.Context, token *AccessToken) error
+
+func personalTokensURL(userSlug string) string {
+	return restURL("access-tokens/1.0", fmt.Sprintf("users/%s", userSlug))
+}
+
+func projectTokensURL(projectKey string) string {
+	return restURL("access-tokens/1.0", fmt.Sprintf("projects/%s", projectKey))
+}
+
+func repositoryTokensURL(projectKey, repositorySlug string) string {
+	return restURL("access-tokens/1.0", fmt.Sprintf("projects/%s/repos/%s", projectKey, repositorySlug))
+}
+
+// ListPersonal retrieves a page of the personal access tokens of a user.
+func (s *AccessTokensService) ListPersonal(ctx context.Context, userSlug string, opts *ListOptions) ([]*AccessToken, *Response, error) {
+	return s.list(ctx, personalTokensURL(userSlug), opts)
+}
+
+// CreatePersonal creates a personal access token for a user. The secret of the
+// token is returned only once, in the Token field.
+func (s *AccessTokensService) CreatePersonal(ctx context.Context, userSlug string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.create(ctx, personalTokensURL(userSlug), token)
+}
+
+// GetPersonal retrieves a personal access token of a user.
+func (s *AccessTokensService) GetPersonal(ctx context.Context, userSlug, id string) (*AccessToken, *Response, error) {
+	return s.get(ctx, personalTokensURL(userSlug), id)
+}
+
+// UpdatePersonal updates the name and the permissions of a personal access token.
+func (s *AccessTokensService) UpdatePersonal(ctx context.Context, userSlug, id string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.update(ctx, personalTokensURL(userSlug), id, token)
+}
+
+// DeletePersonal revokes a personal access token of a user.
+func (s *AccessTokensService) DeletePersonal(ctx context.Context, userSlug, id string) (*Response, error) {
+	return s.delete(ctx, personalTokensURL(userSlug), id)
+}
+
+// RotatePersonal replaces the personal access token with the oldID by a new one.
+//
+// The new token is created from the token request and passed to verify, e.g. to deploy
+// it and check the deployment works. The old token is revoked only after verify succeeds,
+// then the new token is returned with its secret. A nil verify is rejected.
+//
+// If verify fails, the new token is revoked and the old one is kept. The revocation
+// does not use ctx when it is already done, so a cancelled or timed out verification
+// still cleans up. If the revocation fails too, the new token is returned along with
+// the error, so it can be revoked later.
+func (s *AccessTokensService) RotatePersonal(ctx context.Context, userSlug, oldID string, token *AccessTokenRequest, verify VerifyAccessTokenFunc) (*AccessToken, *Response, error) {
+	return s.rotate(ctx, personalTokensURL(userSlug), oldID, token, verify)
+}
+
+// ListProject retrieves a page of the access tokens of a project.
+func (s *AccessTokensService) ListProject(ctx context.Context, projectKey string, opts *ListOptions) ([]*AccessToken, *Response, error) {
+	return s.list(ctx, projectTokensURL(projectKey), opts)
+}
+
+// CreateProject creates an access token for a project. The secret of the token
+// is returned only once, in the Token field.
+func (s *AccessTokensService) CreateProject(ctx context.Context, projectKey string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.create(ctx, projectTokensURL(projectKey), token)
+}
+
+// GetProject retrieves an access token of a project.
+func (s *AccessTokensService) GetProject(ctx context.Context, projectKey, id string) (*AccessToken, *Response, error) {
+	return s.get(ctx, projectTokensURL(projectKey), id)
+}
+
+// UpdateProject updates the name and the permissions of an access token of a project.
+func (s *AccessTokensService) UpdateProject(ctx context.Context, projectKey, id string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.update(ctx, projectTokensURL(projectKey), id, token)
+}
+
+// DeleteProject revokes an access token of a project.
+func (s *AccessTokensService) DeleteProject(ctx context.Context, projectKey, id string) (*Response, error) {
+	return s.delete(ctx, projectTokensURL(projectKey), id)
+}
+
+// RotateProject replaces the access token of a project with the oldID by a new
+// one, like RotatePersonal does.
+func (s *AccessTokensService) RotateProject(ctx context.Context, projectKey, oldID string, token *AccessTokenRequest, verify VerifyAccessTokenFunc) (*AccessToken, *Response, error) {
+	return s.rotate(ctx, projectTokensURL(projectKey), oldID, token, verify)
+}
+
+// ListRepository retrieves a page of the access tokens of a repository.
+func (s *AccessTokensService) ListRepository(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*AccessToken, *Response, error) {
+	return s.list(ctx, repositoryTokensURL(projectKey, repositorySlug), opts)
+}
+
+// CreateRepository creates an access token for a repository. The secret of the
+// token is returned only once, in the Token field.
+func (s *AccessTokensService) CreateRepository(ctx context.Context, projectKey, repositorySlug string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.create(ctx, repositoryTokensURL(projectKey, repositorySlug), token)
+}
+
+// GetRepository retrieves an access token of a repository.
+func (s *AccessTokensService) GetRepository(ctx context.Context, projectKey, repositorySlug, id string) (*AccessToken, *Response, error) {
+	return s.get(ctx, repositoryTokensURL(projectKey, repositorySlug), id)
+}
+
+// UpdateRepository updates the name and the permissions of an access token of a repository.
+func (s *AccessTokensService) UpdateRepository(ctx context.Context, projectKey, repositorySlug, id string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	return s.update(ctx, repositoryTokensURL(projectKey, repositorySlug), id, token)
+}
+
+// DeleteRepository revokes an access token of a repository.
+func (s *AccessTokensService) DeleteRepository(ctx context.Context, projectKey, repositorySlug, id string) (*Response, error) {
+	return s.delete(ctx, repositoryTokensURL(projectKey, repositorySlug), id)
+}
+
+// RotateRepository replaces the access token of a repository with the oldID by a
+// new one, like RotatePersonal does.
+func (s *AccessTokensService) RotateRepository(ctx context.Context, projectKey, repositorySlug, oldID string, token *AccessTokenRequest, verify VerifyAccessTokenFunc) (*AccessToken, *Response, error) {
+	return s.rotate(ctx, repositoryTokensURL(projectKey, repositorySlug), oldID, token, verify)
+}
+
+func (s *AccessTokensService) list(ctx context.Context, u string, opts *ListOptions) ([]*AccessToken, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tokens []*AccessToken
+	page := &pagedResponse{
+		Values: &tokens,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tokens, resp, nil
+}
+
+func (s *AccessTokensService) create(ctx context.Context, u string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PUT", u, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(AccessToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+func (s *AccessTokensService) get(ctx context.Context, u, id string) (*AccessToken, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", u+"/"+id, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(AccessToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+func (s *AccessTokensService) update(ctx context.Context, u, id string, token *AccessTokenRequest) (*AccessToken, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", u+"/"+id, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(AccessToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+func (s *AccessTokensService) delete(ctx context.Context, u, id string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, "DELETE", u+"/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// cleanupTimeout bounds the revocation of a rotated token whose verification failed
+// after the context of the rotation is done.
+const cleanupTimeout = 30 * time.Second
+
+func (s *AccessTokensService) rotate(ctx context.Context, u, oldID string, token *AccessTokenRequest, verify VerifyAccessTokenFunc) (*AccessToken, *Response, error) {
+	if verify == nil {
+		return nil, nil, errors.New("access token rotation requires a verify function")
+	}
+
+	t, resp, err := s.create(ctx, u, token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if err := verify(ctx, t); err != nil {
+		cleanupCtx := ctx
+		if ctx.Err() != nil {
+			var cancel context.CancelFunc
+			cleanupCtx, cancel = context.WithTimeout(context.Background(), cleanupTimeout)
+			defer cancel()
+		}
+
+		resp, cleanupErr := s.delete(cleanupCtx, u, t.ID)
+		if cleanupErr != nil {
+			return t, resp, fmt.Errorf("verifying access token %s: %w (revoking it failed: %v)", t.ID, err, cleanupErr)
+		}
+		return nil, resp, fmt.Errorf("verifying access token %s: %w", t.ID, err)
+	}
+
+	resp, err = s.delete(ctx, u, oldID)
+	if err != nil {
+		return t, resp, fmt.Errorf("revoking access token %s: %w", oldID, err)
+	}
+
+	return t, resp, nil
+}
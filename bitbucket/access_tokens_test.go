@@ -0,0 +1,145 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// tokensServer fakes the personal access tokens of the user jdoe. Creating a token
+// returns the token new, the revoked token IDs are recorded in deleted.
+type tokensServer struct {
+	mu         sync.Mutex
+	deleted    []string
+	failDelete map[string]bool
+}
+
+func (ts *tokensServer) register(mux *http.ServeMux) {
+	mux.HandleFunc("/rest/access-tokens/1.0/users/jdoe", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			http.Error(w, `{"errors":[{"message":"unexpected method"}]}`, http.StatusMethodNotAllowed)
+			return
+		}
+		fmt.Fprint(w, `{"id":"new","name":"ci","token":"secret"}`)
+	})
+	mux.HandleFunc("/rest/access-tokens/1.0/users/jdoe/", func(w http.ResponseWriter, r *http.Request) {
+		id := r.URL.Path[len("/rest/access-tokens/1.0/users/jdoe/"):]
+		if r.Method != "DELETE" || ts.failDelete[id] {
+			http.Error(w, `{"errors":[{"message":"failed"}]}`, http.StatusInternalServerError)
+			return
+		}
+
+		ts.mu.Lock()
+		ts.deleted = append(ts.deleted, id)
+		ts.mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func TestAccessTokensService_RotatePersonal(t *testing.T) {
+	client, mux := setup(t)
+	ts := new(tokensServer)
+	ts.register(mux)
+
+	var verified *AccessToken
+	token, _, err := client.AccessTokens.RotatePersonal(context.Background(), "jdoe", "old", &AccessTokenRequest{Name: "ci"},
+		func(ctx context.Context, token *AccessToken) error {
+			verified = token
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("RotatePersonal returned error: %v", err)
+	}
+
+	want := &AccessToken{ID: "new", Name: "ci", Token: "secret"}
+	if !reflect.DeepEqual(token, want) {
+		t.Errorf("RotatePersonal returned %+v, want %+v", token, want)
+	}
+	if !reflect.DeepEqual(verified, want) {
+		t.Errorf("RotatePersonal verified %+v, want %+v", verified, want)
+	}
+	if want := []string{"old"}; !reflect.DeepEqual(ts.deleted, want) {
+		t.Errorf("RotatePersonal revoked %v, want %v", ts.deleted, want)
+	}
+}
+
+func TestAccessTokensService_RotatePersonal_verifyFailed(t *testing.T) {
+	client, mux := setup(t)
+	ts := new(tokensServer)
+	ts.register(mux)
+
+	verifyErr := errors.New("deployment failed")
+	token, _, err := client.AccessTokens.RotatePersonal(context.Background(), "jdoe", "old", &AccessTokenRequest{Name: "ci"},
+		func(ctx context.Context, token *AccessToken) error {
+			return verifyErr
+		})
+	if !errors.Is(err, verifyErr) {
+		t.Errorf("RotatePersonal returned error %v, want %v", err, verifyErr)
+	}
+	if token != nil {
+		t.Errorf("RotatePersonal returned %+v, want nil", token)
+	}
+	if want := []string{"new"}; !reflect.DeepEqual(ts.deleted, want) {
+		t.Errorf("RotatePersonal revoked %v, want %v", ts.deleted, want)
+	}
+}
+
+func TestAccessTokensService_RotatePersonal_verifyCancelled(t *testing.T) {
+	client, mux := setup(t)
+	ts := new(tokensServer)
+	ts.register(mux)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, _, err := client.AccessTokens.RotatePersonal(ctx, "jdoe", "old", &AccessTokenRequest{Name: "ci"},
+		func(ctx context.Context, token *AccessToken) error {
+			cancel()
+			return ctx.Err()
+		})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RotatePersonal returned error %v, want %v", err, context.Canceled)
+	}
+	if want := []string{"new"}; !reflect.DeepEqual(ts.deleted, want) {
+		t.Errorf("RotatePersonal revoked %v, want %v", ts.deleted, want)
+	}
+}
+
+func TestAccessTokensService_RotatePersonal_cleanupFailed(t *testing.T) {
+	client, mux := setup(t)
+	ts := &tokensServer{failDelete: map[string]bool{"new": true}}
+	ts.register(mux)
+
+	verifyErr := errors.New("deployment failed")
+	token, _, err := client.AccessTokens.RotatePersonal(context.Background(), "jdoe", "old", &AccessTokenRequest{Name: "ci"},
+		func(ctx context.Context, token *AccessToken) error {
+			return verifyErr
+		})
+	if !errors.Is(err, verifyErr) {
+		t.Errorf("RotatePersonal returned error %v, want %v", err, verifyErr)
+	}
+	if token == nil || token.ID != "new" || token.Token != "secret" {
+		t.Errorf("RotatePersonal returned %+v, want the new token", token)
+	}
+	if len(ts.deleted) != 0 {
+		t.Errorf("RotatePersonal revoked %v, want none", ts.deleted)
+	}
+}
+
+func TestAccessTokensService_RotatePersonal_nilVerify(t *testing.T) {
+	client, mux := setup(t)
+	ts := new(tokensServer)
+	ts.register(mux)
+
+	token, _, err := client.AccessTokens.RotatePersonal(context.Background(), "jdoe", "old", &AccessTokenRequest{Name: "ci"}, nil)
+	if err == nil {
+		t.Error("RotatePersonal returned no error, want one")
+	}
+	if token != nil || len(ts.deleted) != 0 {
+		t.Errorf("RotatePersonal returned %+v and revoked %v, want nothing", token, ts.deleted)
+	}
+}
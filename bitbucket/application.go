@@ -0,0 +1,111 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ApplicationProperties represents the version information of a Bitbucket Server instance.
+type ApplicationProperties struct {
+	Version     string `json:"version,omitempty"`
+	BuildNumber string `json:"buildNumber,omitempty"`
+	BuildDate   string `json:"buildDate,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// GetApplicationProperties retrieves the version information of the server.
+func (c *Client) GetApplicationProperties(ctx context.Context) (*ApplicationProperties, *Response, error) {
+	req, err := c.NewRequest(ctx, "GET", "application-properties", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	props := new(ApplicationProperties)
+	resp, err := c.Do(req, props)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return props, resp, nil
+}
+
+// serverVersion returns the version of the server. The version is retrieved on
+// the first call and then cached for the lifetime of the Client.
+func (c *Client) serverVersion(ctx context.Context) (string, error) {
+	c.versionMu.Lock()
+	defer c.versionMu.Unlock()
+
+	if c.version != "" {
+		return c.version, nil
+	}
+
+	props, _, err := c.GetApplicationProperties(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.version = props.Version
+	return c.version, nil
+}
+
+// serverVersionAtLeast reports whether the server version is major.minor or later.
+func (c *Client) serverVersionAtLeast(ctx context.Context, major, minor int) (bool, error) {
+	v, err := c.serverVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return versionAtLeast(v, major, minor), nil
+}
+
+// UnsupportedVersionError is returned by the methods that call endpoints which are
+// not available on the version of the server.
+type UnsupportedVersionError struct {
+	// Feature the name of the unsupported feature.
+	Feature string
+
+	// Required the first server version that supports the feature.
+	Required string
+
+	// Version the version of the server.
+	Version string
+}
+
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("%s requires Bitbucket Server %s or later, the server version is %s", e.Feature, e.Required, e.Version)
+}
+
+// requireVersion returns an UnsupportedVersionError if the server version is older than major.minor.
+func (c *Client) requireVersion(ctx context.Context, feature string, major, minor int) error {
+	v, err := c.serverVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !versionAtLeast(v, major, minor) {
+		return &UnsupportedVersionError{
+			Feature:  feature,
+			Required: fmt.Sprintf("%d.%d", major, minor),
+			Version:  v,
+		}
+	}
+	return nil
+}
+
+// versionAtLeast reports whether the version v (e.g., 7.21.0) is major.minor or later.
+// Unparsable components are treated as zero.
+func versionAtLeast(v string, major, minor int) bool {
+	parts := strings.SplitN(v, ".", 3)
+
+	var got [2]int
+	for i := 0; i < len(got) && i < len(parts); i++ {
+		got[i], _ = strconv.Atoi(parts[i])
+	}
+
+	if got[0] != major {
+		return got[0] > major
+	}
+	return got[1] >= minor
+}
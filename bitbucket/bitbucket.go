@@ -13,6 +13,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -33,10 +34,23 @@ type Client struct {
 	// Base URL for API requests.
 	baseURL *url.URL
 
+	// The version of the server, it is retrieved once and cached (see serverVersion).
+	versionMu sync.Mutex
+	version   string
+
 	// Services used for talking to different parts of the Bitbucket Server API.
-	Users        *UsersService
-	Repositories *RepositoriesService
-	PullRequests *PullRequestsService
+	Users             *UsersService
+	Projects          *ProjectsService
+	Repositories      *RepositoriesService
+	PullRequests      *PullRequestsService
+	DefaultReviewers  *DefaultReviewersService
+	Builds            *BuildsService
+	Insights          *InsightsService
+	Deployments       *DeploymentsService
+	BranchPermissions *BranchPermissionsService
+	Groups            *GroupsService
+	Keys              *KeysService
+	AccessTokens      *AccessTokensService
 }
 
 func (c *Client) BaseURL() url.URL {
@@ -53,6 +67,13 @@ type ListOptions struct {
 	Limit int `url:"limit,omitempty"`
 }
 
+// versionOptions specifies the version parameter required by the endpoints that
+// guard against concurrent modifications (e.g., deleting a comment).
+type versionOptions struct {
+	// Version the expected version of the resource.
+	Version int `url:"version"`
+}
+
 // addOptions adds the parameters in opt as URL query parameters to s. opt
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -97,8 +118,17 @@ func NewServerClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c := &Client{client: httpClient, baseURL: baseEndpoint, UserAgent: userAgent}
 	c.common.client = c
 	c.Users = (*UsersService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
 	c.Repositories = (*RepositoriesService)(&c.common)
 	c.PullRequests = (*PullRequestsService)(&c.common)
+	c.DefaultReviewers = (*DefaultReviewersService)(&c.common)
+	c.Builds = (*BuildsService)(&c.common)
+	c.Insights = (*InsightsService)(&c.common)
+	c.Deployments = (*DeploymentsService)(&c.common)
+	c.BranchPermissions = (*BranchPermissionsService)(&c.common)
+	c.Groups = (*GroupsService)(&c.common)
+	c.Keys = (*KeysService)(&c.common)
+	c.AccessTokens = (*AccessTokensService)(&c.common)
 
 	return c, nil
 }
@@ -107,10 +137,19 @@ type service struct {
 	client *Client
 }
 
+// restURL returns the URL, relative to the base URL of the Client, of a path under
+// another Bitbucket Server REST API such as `default-reviewers/1.0`. These APIs live
+// next to the core API (i.e., `/rest/api/1.0/`), so the result keeps any context path
+// of the base URL, unlike URLs with a preceding slash.
+func restURL(api, path string) string {
+	return "../../" + api + "/" + path
+}
+
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
 // in which case it is resolved relative to the baseURL of the Client.
 // Relative URLs should always be specified without a preceding slash, otherwise
 // the URL will be relative root of the base URL (ignoring the API suffix i.e., `/rest/api/1.0/`).
+// URLs of the other REST APIs (e.g., `/rest/build-status/1.0/`) can be built with restURL.
 // If specified, the value pointed to by body is JSON encoded and included as the
 // request body.
 func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
@@ -261,11 +300,22 @@ type Link struct {
 	Href string `json:"href,omitempty"`
 }
 
+// Scope represents the project or repository a resource (e.g., a hook setting) applies to.
+type Scope struct {
+	Type       string `json:"type,omitempty"` // either PROJECT, REPOSITORY or GLOBAL
+	ResourceID int    `json:"resourceId,omitempty"`
+}
+
 type Time struct {
 	time.Time
 }
 
 func (t *Time) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Time{}
+		return nil
+	}
+
 	millis, err := strconv.ParseInt(string(data), 10, 64)
 	if err != nil {
 		return err
@@ -276,6 +326,11 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 }
 
 func (t Time) MarshalJSON() ([]byte, error) {
+	// a zero time is encoded as null so read-only dates are not sent back to the server
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
 	return []byte(strconv.FormatInt(t.Time.UnixNano()/1000000, 10)), nil
 }
 
@@ -300,3 +355,7 @@ type Error struct {
 func (e *Error) Error() string {
 	return e.Message
 }
+
+// Bool is a helper routine that allocates a new bool value
+// to store v and returns a pointer to it.
+func Bool(v bool) *bool { return &v }
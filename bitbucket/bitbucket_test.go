@@ -0,0 +1,24 @@
+package bitbucket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// setup starts a test HTTP server and returns a client configured to talk to it,
+// along with the mux to register the handlers of the tested endpoints on.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := NewServerClient(server.URL, nil)
+	if err != nil {
+		t.Fatalf("NewServerClient returned error: %v", err)
+	}
+
+	return client, mux
+}
@@ -0,0 +1,183 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	RestrictionTypeReadOnly        = "read-only"
+	RestrictionTypeNoDeletes       = "no-deletes"
+	RestrictionTypeFastForwardOnly = "fast-forward-only"
+	RestrictionTypePullRequestOnly = "pull-request-only"
+)
+
+// BranchPermissionsService handles communication with the branch permissions
+// (i.e., ref restrictions) related methods of the Bitbucket Server API.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-ref-restriction-rest.html
+type BranchPermissionsService service
+
+// RefRestriction represents a restriction on the refs matched by its Matcher. The
+// restriction does not apply to the exempted users, groups and access keys.
+type RefRestriction struct {
+	ID    int    `json:"id,omitempty"`
+	Scope *Scope `json:"scope,omitempty"`
+
+	// Type either read-only, no-deletes, fast-forward-only or pull-request-only.
+	Type string `json:"type,omitempty"`
+
+	// Matcher the refs the restriction applies to, its type is either BRANCH,
+	// PATTERN, MODEL_CATEGORY or MODEL_BRANCH.
+	Matcher *RefMatcher `json:"matcher,omitempty"`
+
+	Users      []*User      `json:"users,omitempty"`
+	Groups     []string     `json:"groups,omitempty"`
+	AccessKeys []*AccessKey `json:"accessKeys,omitempty"`
+}
+
+// RefRestrictionRequest is the payload used to create a ref restriction.
+type RefRestrictionRequest struct {
+	Type    string      `json:"type"`
+	Matcher *RefMatcher `json:"matcher"`
+
+	// Users (optional) the slugs of the exempted users.
+	Users []string `json:"users,omitempty"`
+
+	// Groups (optional) the names of the exempted groups.
+	Groups []string `json:"groups,omitempty"`
+
+	// AccessKeyIDs (optional) the IDs of the exempted access keys.
+	AccessKeyIDs []int `json:"accessKeyIds,omitempty"`
+}
+
+// ListRefRestrictionsOptions specifies the optional parameters to the
+// BranchPermissionsService list methods.
+type ListRefRestrictionsOptions struct {
+	// Type (optional) return only the restrictions of this type.
+	Type string `url:"type,omitempty"`
+
+	// MatcherType (optional) return only the restrictions with this matcher type.
+	MatcherType string `url:"matcherType,omitempty"`
+
+	// MatcherID (optional) return only the restrictions with this matcher ID, it
+	// requires MatcherType.
+	MatcherID string `url:"matcherId,omitempty"`
+
+	ListOptions
+}
+
+// ListProjectRestrictions retrieves a page of the ref restrictions of a project.
+func (s *BranchPermissionsService) ListProjectRestrictions(ctx context.Context, projectKey string, opts *ListRefRestrictionsOptions) ([]*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/restrictions", projectKey))
+	return s.listRestrictions(ctx, u, opts)
+}
+
+// ListRestrictions retrieves a page of the ref restrictions of a repository.
+func (s *BranchPermissionsService) ListRestrictions(ctx context.Context, projectKey, repositorySlug string, opts *ListRefRestrictionsOptions) ([]*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/repos/%s/restrictions", projectKey, repositorySlug))
+	return s.listRestrictions(ctx, u, opts)
+}
+
+func (s *BranchPermissionsService) listRestrictions(ctx context.Context, u string, opts *ListRefRestrictionsOptions) ([]*RefRestriction, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var restrictions []*RefRestriction
+	page := &pagedResponse{
+		Values: &restrictions,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return restrictions, resp, nil
+}
+
+// GetProjectRestriction retrieves a ref restriction of a project.
+func (s *BranchPermissionsService) GetProjectRestriction(ctx context.Context, projectKey string, id int) (*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/restrictions/%v", projectKey, id))
+	return s.getRestriction(ctx, u)
+}
+
+// GetRestriction retrieves a ref restriction of a repository.
+func (s *BranchPermissionsService) GetRestriction(ctx context.Context, projectKey, repositorySlug string, id int) (*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/repos/%s/restrictions/%v", projectKey, repositorySlug, id))
+	return s.getRestriction(ctx, u)
+}
+
+func (s *BranchPermissionsService) getRestriction(ctx context.Context, u string) (*RefRestriction, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RefRestriction)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// CreateProjectRestriction creates a ref restriction for a project. If an identical
+// restriction exists, it is updated with the exempted users, groups and access keys.
+func (s *BranchPermissionsService) CreateProjectRestriction(ctx context.Context, projectKey string, restriction *RefRestrictionRequest) (*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/restrictions", projectKey))
+	return s.createRestriction(ctx, u, restriction)
+}
+
+// CreateRestriction creates a ref restriction for a repository. If an identical
+// restriction exists, it is updated with the exempted users, groups and access keys.
+func (s *BranchPermissionsService) CreateRestriction(ctx context.Context, projectKey, repositorySlug string, restriction *RefRestrictionRequest) (*RefRestriction, *Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/repos/%s/restrictions", projectKey, repositorySlug))
+	return s.createRestriction(ctx, u, restriction)
+}
+
+func (s *BranchPermissionsService) createRestriction(ctx context.Context, u string, restriction *RefRestrictionRequest) (*RefRestriction, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", u, restriction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RefRestriction)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteProjectRestriction deletes a ref restriction of a project.
+func (s *BranchPermissionsService) DeleteProjectRestriction(ctx context.Context, projectKey string, id int) (*Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/restrictions/%v", projectKey, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteRestriction deletes a ref restriction of a repository.
+func (s *BranchPermissionsService) DeleteRestriction(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := restURL("branch-permissions/2.0", fmt.Sprintf("projects/%s/repos/%s/restrictions/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,199 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	BuildStateInProgress = "INPROGRESS"
+	BuildStateSuccessful = "SUCCESSFUL"
+	BuildStateFailed     = "FAILED"
+	BuildStateCancelled  = "CANCELLED"
+	BuildStateUnknown    = "UNKNOWN"
+)
+
+// BuildsService handles communication with the build status related
+// methods of the Bitbucket Server API.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-build-rest.html
+//
+// The repository scoped methods, CreateForRepository and GetForRepository, are documented at:
+// https://developer.atlassian.com/server/bitbucket/rest/v810/api-group-builds-and-deployments/
+type BuildsService service
+
+// BuildStatus represents the result of a build of a commit.
+type BuildStatus struct {
+	// Key a unique identifier of the build, e.g. the CI plan key.
+	Key string `json:"key,omitempty"`
+
+	// State either INPROGRESS, SUCCESSFUL, FAILED, CANCELLED or UNKNOWN.
+	State string `json:"state,omitempty"`
+
+	// URL the link to the build result.
+	URL string `json:"url,omitempty"`
+
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	DateAdded   Time   `json:"dateAdded,omitempty"`
+
+	// The following fields are supported only by the repository scoped builds endpoints.
+
+	// Duration the duration of the build in milliseconds.
+	Duration    int64        `json:"duration,omitempty"`
+	BuildNumber string       `json:"buildNumber,omitempty"`
+	Parent      string       `json:"parent,omitempty"`
+	Ref         string       `json:"ref,omitempty"`
+	TestResults *TestResults `json:"testResults,omitempty"`
+}
+
+// TestResults holds the number of the tests run by a build grouped by their result.
+type TestResults struct {
+	Successful int `json:"successful"`
+	Failed     int `json:"failed"`
+	Skipped    int `json:"skipped"`
+}
+
+// BuildStats holds the number of the builds of a commit grouped by their state.
+type BuildStats struct {
+	Successful int `json:"successful"`
+	Failed     int `json:"failed"`
+	InProgress int `json:"inProgress"`
+	Cancelled  int `json:"cancelled"`
+	Unknown    int `json:"unknown"`
+
+	// Results this populated only when there is a single build and IncludeUnique is requested.
+	Results []*BuildStatus `json:"results,omitempty"`
+}
+
+// Create associates a build status with a commit.
+func (s *BuildsService) Create(ctx context.Context, commitID string, status *BuildStatus) (*Response, error) {
+	u := restURL("build-status/1.0", fmt.Sprintf("commits/%s", commitID))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListBuildStatusOptions specifies the optional parameters to the BuildsService.List method.
+type ListBuildStatusOptions struct {
+	// OrderBy (optional, defaults to NEWEST) either NEWEST, OLDEST or STATUS.
+	OrderBy string `url:"orderBy,omitempty"`
+
+	ListOptions
+}
+
+// List retrieves a page of the build statuses associated with a commit.
+func (s *BuildsService) List(ctx context.Context, commitID string, opts *ListBuildStatusOptions) ([]*BuildStatus, *Response, error) {
+	u := restURL("build-status/1.0", fmt.Sprintf("commits/%s", commitID))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses []*BuildStatus
+	page := &pagedResponse{
+		Values: &statuses,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
+
+// BuildStatsOptions specifies the optional parameters to the BuildsService.GetStats method.
+type BuildStatsOptions struct {
+	// IncludeUnique (optional, defaults to false) if true, the build status is
+	// included in the result when the commit has a single build.
+	IncludeUnique bool `url:"includeUnique,omitempty"`
+}
+
+// GetStats retrieves the number of builds of a commit grouped by their state.
+func (s *BuildsService) GetStats(ctx context.Context, commitID string, opts *BuildStatsOptions) (*BuildStats, *Response, error) {
+	u := restURL("build-status/1.0", fmt.Sprintf("commits/stats/%s", commitID))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(BuildStats)
+	resp, err := s.client.Do(req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// ListStats retrieves the build stats of multiple commits in one request, the
+// result is keyed by the commit ID.
+func (s *BuildsService) ListStats(ctx context.Context, commitIDs []string) (map[string]*BuildStats, *Response, error) {
+	u := restURL("build-status/1.0", "commits/stats")
+
+	req, err := s.client.NewRequest(ctx, "POST", u, commitIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := make(map[string]*BuildStats)
+	resp, err := s.client.Do(req, &stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+// CreateForRepository associates a build status with a commit in a repository. Unlike
+// Create, the status can hold the duration, the test results and the ref of the build.
+func (s *BuildsService) CreateForRepository(ctx context.Context, projectKey, repositorySlug, commitID string, status *BuildStatus) (*Response, error) {
+	u := restURL("api/latest", fmt.Sprintf("projects/%s/repos/%s/commits/%s/builds", projectKey, repositorySlug, commitID))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+type buildKeyOptions struct {
+	Key string `url:"key"`
+}
+
+// GetForRepository retrieves the build status with the given key of a commit in a repository.
+func (s *BuildsService) GetForRepository(ctx context.Context, projectKey, repositorySlug, commitID, key string) (*BuildStatus, *Response, error) {
+	u := restURL("api/latest", fmt.Sprintf("projects/%s/repos/%s/commits/%s/builds", projectKey, repositorySlug, commitID))
+	u, err := addOptions(u, &buildKeyOptions{Key: key})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(BuildStatus)
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return status, resp, nil
+}
@@ -0,0 +1,122 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// RequiredBuildCondition represents a merge check that requires builds to pass on the
+// latest commit of the pull requests targeting the matched refs before they can be merged.
+// Required builds are supported since Bitbucket Server 7.14.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.14.0/bitbucket-required-builds-rest.html
+type RequiredBuildCondition struct {
+	ID int `json:"id,omitempty"`
+
+	// BuildParentKeys the keys of the builds that must pass (see BuildStatus.Parent).
+	BuildParentKeys []string `json:"buildParentKeys"`
+
+	// RefMatcher the target refs of the pull requests the condition applies to.
+	RefMatcher *RefMatcher `json:"refMatcher"`
+
+	// ExemptRefMatcher (optional) the source refs exempted from the condition.
+	ExemptRefMatcher *RefMatcher `json:"exemptRefMatcher,omitempty"`
+}
+
+// ListRequiredBuilds retrieves a page of the required builds conditions of a repository.
+func (s *BuildsService) ListRequiredBuilds(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/repos/%s/conditions", projectKey, repositorySlug))
+	return s.listRequiredBuilds(ctx, u, opts)
+}
+
+// ListProjectRequiredBuilds retrieves a page of the required builds conditions of a project.
+func (s *BuildsService) ListProjectRequiredBuilds(ctx context.Context, projectKey string, opts *ListOptions) ([]*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/conditions", projectKey))
+	return s.listRequiredBuilds(ctx, u, opts)
+}
+
+func (s *BuildsService) listRequiredBuilds(ctx context.Context, u string, opts *ListOptions) ([]*RequiredBuildCondition, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conditions []*RequiredBuildCondition
+	page := &pagedResponse{
+		Values: &conditions,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return conditions, resp, nil
+}
+
+// CreateRequiredBuild creates a required builds condition for a repository.
+func (s *BuildsService) CreateRequiredBuild(ctx context.Context, projectKey, repositorySlug string, condition *RequiredBuildCondition) (*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/repos/%s/condition", projectKey, repositorySlug))
+	return s.saveRequiredBuild(ctx, "POST", u, condition)
+}
+
+// CreateProjectRequiredBuild creates a required builds condition for a project.
+func (s *BuildsService) CreateProjectRequiredBuild(ctx context.Context, projectKey string, condition *RequiredBuildCondition) (*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/condition", projectKey))
+	return s.saveRequiredBuild(ctx, "POST", u, condition)
+}
+
+// UpdateRequiredBuild updates a required builds condition of a repository.
+func (s *BuildsService) UpdateRequiredBuild(ctx context.Context, projectKey, repositorySlug string, id int, condition *RequiredBuildCondition) (*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/repos/%s/condition/%v", projectKey, repositorySlug, id))
+	return s.saveRequiredBuild(ctx, "PUT", u, condition)
+}
+
+// UpdateProjectRequiredBuild updates a required builds condition of a project.
+func (s *BuildsService) UpdateProjectRequiredBuild(ctx context.Context, projectKey string, id int, condition *RequiredBuildCondition) (*RequiredBuildCondition, *Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/condition/%v", projectKey, id))
+	return s.saveRequiredBuild(ctx, "PUT", u, condition)
+}
+
+func (s *BuildsService) saveRequiredBuild(ctx context.Context, method, u string, condition *RequiredBuildCondition) (*RequiredBuildCondition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, condition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(RequiredBuildCondition)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// DeleteRequiredBuild deletes a required builds condition of a repository.
+func (s *BuildsService) DeleteRequiredBuild(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/repos/%s/condition/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteProjectRequiredBuild deletes a required builds condition of a project.
+func (s *BuildsService) DeleteProjectRequiredBuild(ctx context.Context, projectKey string, id int) (*Response, error) {
+	u := restURL("required-builds/latest", fmt.Sprintf("projects/%s/condition/%v", projectKey, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,187 @@
+package bitbucket
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	defaultWaitInterval    = 5 * time.Second
+	defaultWaitMaxInterval = time.Minute
+)
+
+// WaitForBuildsOptions specifies the optional parameters to the
+// BuildsService.WaitForBuilds method.
+type WaitForBuildsOptions struct {
+	// RequiredKeys (optional) the keys of the builds to wait for, the other builds
+	// are ignored. If it is empty, the wait ends once at least one build is reported
+	// and all the reported builds are settled.
+	RequiredKeys []string
+
+	// Timeout (optional) the maximum duration to wait, zero means to wait until
+	// the context is done.
+	Timeout time.Duration
+
+	// Interval (optional, defaults to 5 seconds) the delay before the second poll,
+	// the delay is doubled after each poll up to MaxInterval.
+	Interval time.Duration
+
+	// MaxInterval (optional, defaults to 1 minute) the maximum delay between polls.
+	MaxInterval time.Duration
+
+	// OnUpdate (optional) is called after each poll with the latest status of each build.
+	OnUpdate func(statuses []*BuildStatus)
+}
+
+// BuildsSummary groups the keys of the builds of a commit by their latest state.
+type BuildsSummary struct {
+	Successful []string
+
+	// Failed the keys of the failed, the cancelled and the unknown builds.
+	Failed []string
+
+	// InProgress the keys of the builds that are not settled yet.
+	InProgress []string
+
+	// Missing the required keys that have not been reported yet.
+	Missing []string
+}
+
+// Settled reports whether all the builds reached a terminal state.
+func (s *BuildsSummary) Settled() bool {
+	return len(s.InProgress) == 0 && len(s.Missing) == 0
+}
+
+// Passed reports whether at least one build was reported, all the builds settled
+// and none of them failed.
+func (s *BuildsSummary) Passed() bool {
+	return s.Settled() && len(s.Failed) == 0 && len(s.Successful) > 0
+}
+
+// WaitForBuilds polls the build statuses of a commit until the builds are settled, i.e.,
+// reached SUCCESSFUL, FAILED, CANCELLED or UNKNOWN state. It returns the summary of the last poll,
+// along with the context error if the timeout elapsed or the context is done first.
+func (s *BuildsService) WaitForBuilds(ctx context.Context, commitID string, opts *WaitForBuildsOptions) (*BuildsSummary, error) {
+	if opts == nil {
+		opts = &WaitForBuildsOptions{}
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = defaultWaitInterval
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = defaultWaitMaxInterval
+	}
+
+	summary := &BuildsSummary{Missing: opts.RequiredKeys}
+	for {
+		statuses, err := s.listLatestStatuses(ctx, commitID)
+		if err != nil {
+			return summary, err
+		}
+
+		if opts.OnUpdate != nil {
+			opts.OnUpdate(statuses)
+		}
+
+		summary = summarizeBuilds(statuses, opts.RequiredKeys)
+		if buildsDone(summary, opts.RequiredKeys, len(statuses)) {
+			return summary, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return summary, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = nextWaitInterval(interval, maxInterval)
+	}
+}
+
+// buildsDone reports whether the wait can end. Without required keys, an empty
+// list of statuses is not settled since the builds may not have started yet.
+func buildsDone(summary *BuildsSummary, requiredKeys []string, reported int) bool {
+	return summary.Settled() && (len(requiredKeys) > 0 || reported > 0)
+}
+
+// nextWaitInterval doubles the interval up to maxInterval.
+func nextWaitInterval(interval, maxInterval time.Duration) time.Duration {
+	interval *= 2
+	if interval > maxInterval {
+		return maxInterval
+	}
+	return interval
+}
+
+// listLatestStatuses retrieves all the pages of the build statuses of a commit and
+// keeps only the latest status of each build key.
+func (s *BuildsService) listLatestStatuses(ctx context.Context, commitID string) ([]*BuildStatus, error) {
+	var latest []*BuildStatus
+	seen := make(map[string]bool)
+
+	opts := &ListBuildStatusOptions{OrderBy: "NEWEST"}
+	for {
+		statuses, resp, err := s.List(ctx, commitID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, status := range statuses {
+			if !seen[status.Key] {
+				seen[status.Key] = true
+				latest = append(latest, status)
+			}
+		}
+
+		if resp.IsLastPage {
+			return latest, nil
+		}
+		opts.Start = resp.NextPageStart
+	}
+}
+
+func summarizeBuilds(statuses []*BuildStatus, requiredKeys []string) *BuildsSummary {
+	summary := new(BuildsSummary)
+	reported := make(map[string]bool)
+
+	required := make(map[string]bool)
+	for _, key := range requiredKeys {
+		required[key] = true
+	}
+
+	for _, status := range statuses {
+		// only the required builds are considered when they are specified
+		if len(required) > 0 && !required[status.Key] {
+			continue
+		}
+		reported[status.Key] = true
+
+		switch status.State {
+		case BuildStateSuccessful:
+			summary.Successful = append(summary.Successful, status.Key)
+		case BuildStateFailed, BuildStateCancelled, BuildStateUnknown:
+			summary.Failed = append(summary.Failed, status.Key)
+		default:
+			summary.InProgress = append(summary.InProgress, status.Key)
+		}
+	}
+
+	for _, key := range requiredKeys {
+		if !reported[key] {
+			summary.Missing = append(summary.Missing, key)
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,195 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSummarizeBuilds(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []*BuildStatus
+		requiredKeys []string
+		want         *BuildsSummary
+		settled      bool
+		passed       bool
+		done         bool
+	}{
+		{
+			name:    "nothing reported",
+			want:    &BuildsSummary{},
+			settled: true,
+			passed:  false,
+			done:    false,
+		},
+		{
+			name: "all successful",
+			statuses: []*BuildStatus{
+				{Key: "a", State: BuildStateSuccessful},
+				{Key: "b", State: BuildStateSuccessful},
+			},
+			want:    &BuildsSummary{Successful: []string{"a", "b"}},
+			settled: true,
+			passed:  true,
+			done:    true,
+		},
+		{
+			name: "in progress",
+			statuses: []*BuildStatus{
+				{Key: "a", State: BuildStateSuccessful},
+				{Key: "b", State: BuildStateInProgress},
+			},
+			want:    &BuildsSummary{Successful: []string{"a"}, InProgress: []string{"b"}},
+			settled: false,
+			passed:  false,
+			done:    false,
+		},
+		{
+			name: "failed, cancelled and unknown are settled",
+			statuses: []*BuildStatus{
+				{Key: "a", State: BuildStateFailed},
+				{Key: "b", State: BuildStateCancelled},
+				{Key: "c", State: BuildStateUnknown},
+			},
+			want:    &BuildsSummary{Failed: []string{"a", "b", "c"}},
+			settled: true,
+			passed:  false,
+			done:    true,
+		},
+		{
+			name: "required build missing",
+			statuses: []*BuildStatus{
+				{Key: "a", State: BuildStateSuccessful},
+			},
+			requiredKeys: []string{"a", "b"},
+			want:         &BuildsSummary{Successful: []string{"a"}, Missing: []string{"b"}},
+			settled:      false,
+			passed:       false,
+			done:         false,
+		},
+		{
+			name: "builds that are not required are ignored",
+			statuses: []*BuildStatus{
+				{Key: "a", State: BuildStateSuccessful},
+				{Key: "b", State: BuildStateInProgress},
+			},
+			requiredKeys: []string{"a"},
+			want:         &BuildsSummary{Successful: []string{"a"}},
+			settled:      true,
+			passed:       true,
+			done:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := summarizeBuilds(tt.statuses, tt.requiredKeys)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("summarizeBuilds() = %+v, want %+v", got, tt.want)
+			}
+			if got.Settled() != tt.settled {
+				t.Errorf("Settled() = %v, want %v", got.Settled(), tt.settled)
+			}
+			if got.Passed() != tt.passed {
+				t.Errorf("Passed() = %v, want %v", got.Passed(), tt.passed)
+			}
+			if done := buildsDone(got, tt.requiredKeys, len(tt.statuses)); done != tt.done {
+				t.Errorf("buildsDone() = %v, want %v", done, tt.done)
+			}
+		})
+	}
+}
+
+func TestNextWaitInterval(t *testing.T) {
+	tests := []struct {
+		interval, max, want time.Duration
+	}{
+		{5 * time.Second, time.Minute, 10 * time.Second},
+		{40 * time.Second, time.Minute, time.Minute},
+		{time.Minute, time.Minute, time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := nextWaitInterval(tt.interval, tt.max); got != tt.want {
+			t.Errorf("nextWaitInterval(%v, %v) = %v, want %v", tt.interval, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestWaitForBuilds(t *testing.T) {
+	client, mux := setup(t)
+
+	var polls int32
+	mux.HandleFunc("/rest/build-status/1.0/commits/abc", func(w http.ResponseWriter, r *http.Request) {
+		state := BuildStateInProgress
+		if atomic.AddInt32(&polls, 1) >= 3 {
+			state = BuildStateSuccessful
+		}
+		fmt.Fprintf(w, `{"isLastPage":true,"values":[{"key":"ci","state":%q},{"key":"lint","state":"SUCCESSFUL"}]}`, state)
+	})
+
+	var updates int
+	summary, err := client.Builds.WaitForBuilds(context.Background(), "abc", &WaitForBuildsOptions{
+		RequiredKeys: []string{"ci"},
+		Interval:     time.Millisecond,
+		OnUpdate: func(statuses []*BuildStatus) {
+			updates++
+			if len(statuses) != 2 {
+				t.Errorf("OnUpdate got %d statuses, want 2", len(statuses))
+			}
+		},
+	})
+	if err != nil {
+		t.Fatalf("WaitForBuilds returned error: %v", err)
+	}
+	if !summary.Passed() {
+		t.Errorf("WaitForBuilds summary = %+v, want passed", summary)
+	}
+	if updates != 3 {
+		t.Errorf("OnUpdate called %d times, want 3", updates)
+	}
+}
+
+func TestWaitForBuilds_timeout(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/rest/build-status/1.0/commits/abc", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"isLastPage":true,"values":[]}`)
+	})
+
+	summary, err := client.Builds.WaitForBuilds(context.Background(), "abc", &WaitForBuildsOptions{
+		Timeout:  20 * time.Millisecond,
+		Interval: time.Millisecond,
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("WaitForBuilds returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if summary.Passed() {
+		t.Errorf("WaitForBuilds summary = %+v, want not passed", summary)
+	}
+}
+
+func TestWaitForBuilds_cancelled(t *testing.T) {
+	client, mux := setup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mux.HandleFunc("/rest/build-status/1.0/commits/abc", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"isLastPage":true,"values":[{"key":"ci","state":"INPROGRESS"}]}`)
+	})
+
+	summary, err := client.Builds.WaitForBuilds(ctx, "abc", &WaitForBuildsOptions{
+		Interval: time.Hour,
+		OnUpdate: func([]*BuildStatus) { cancel() },
+	})
+	if err != context.Canceled {
+		t.Errorf("WaitForBuilds returned error %v, want %v", err, context.Canceled)
+	}
+	if want := []string{"ci"}; !reflect.DeepEqual(summary.InProgress, want) {
+		t.Errorf("WaitForBuilds in progress = %v, want %v", summary.InProgress, want)
+	}
+}
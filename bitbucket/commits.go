@@ -0,0 +1,87 @@
+package bitbucket
+
+const (
+	ChangeTypeAdd    = "ADD"
+	ChangeTypeCopy   = "COPY"
+	ChangeTypeDelete = "DELETE"
+	ChangeTypeModify = "MODIFY"
+	ChangeTypeMove   = "MOVE"
+)
+
+const (
+	SignatureStateVerified   = "VERIFIED"
+	SignatureStateUnverified = "UNVERIFIED"
+	SignatureStateExpired    = "EXPIRED"
+	SignatureStateRevoked    = "REVOKED"
+	SignatureStateInvalid    = "INVALID"
+)
+
+// Commit represents a Git commit in a Bitbucket Server repository.
+type Commit struct {
+	ID                 string    `json:"id,omitempty"`
+	DisplayID          string    `json:"displayId,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	Author             *User     `json:"author,omitempty"` // only Name and EmailAddress are populated for authors without Bitbucket Server accounts
+	AuthorTimestamp    Time      `json:"authorTimestamp,omitempty"`
+	Committer          *User     `json:"committer,omitempty"`
+	CommitterTimestamp Time      `json:"committerTimestamp,omitempty"`
+	Parents            []*Commit `json:"parents,omitempty"` // only ID and DisplayID are populated for parents
+
+	Properties *CommitProperties `json:"properties,omitempty"`
+}
+
+// CommitProperties holds the additional data attached to a commit by the server.
+type CommitProperties struct {
+	// Signature the result of verifying the GPG signature of the commit, it is nil
+	// for unsigned commits.
+	Signature *CommitSignature `json:"signature,omitempty"`
+}
+
+// CommitSignature represents the verification result of a commit signature.
+type CommitSignature struct {
+	// State either VERIFIED, UNVERIFIED (signed by an unknown key), EXPIRED, REVOKED or INVALID.
+	State string `json:"state,omitempty"`
+
+	// Fingerprint the fingerprint of the key that signed the commit.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Signer the user who owns the signing key, this populated only for verified signatures.
+	Signer *User `json:"signer,omitempty"`
+}
+
+// Signed reports whether the commit has a signature.
+func (c *Commit) Signed() bool {
+	return c.Properties != nil && c.Properties.Signature != nil
+}
+
+// Verified reports whether the commit has a signature verified by a known key.
+func (c *Commit) Verified() bool {
+	return c.Signed() && c.Properties.Signature.State == SignatureStateVerified
+}
+
+// Change represents a change made to a file (or a directory) by a commit or a pull request.
+type Change struct {
+	ContentID        string     `json:"contentId,omitempty"`
+	FromContentID    string     `json:"fromContentId,omitempty"`
+	Path             *Path      `json:"path,omitempty"`
+	SrcPath          *Path      `json:"srcPath,omitempty"` // this populated only for moved and copied files
+	Executable       bool       `json:"executable,omitempty"`
+	SrcExecutable    bool       `json:"srcExecutable,omitempty"`
+	PercentUnchanged int        `json:"percentUnchanged,omitempty"`
+	Type             string     `json:"type,omitempty"`
+	NodeType         string     `json:"nodeType,omitempty"`
+	Links            *SelfLinks `json:"links,omitempty"`
+}
+
+// Path represents a path of a file in a repository.
+type Path struct {
+	Components []string `json:"components,omitempty"`
+	Parent     string   `json:"parent,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Extension  string   `json:"extension,omitempty"`
+	ToString   string   `json:"toString,omitempty"`
+}
+
+func (p *Path) String() string {
+	return p.ToString
+}
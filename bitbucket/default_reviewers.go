@@ -0,0 +1,191 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	RefMatcherTypeAnyRef        = "ANY_REF"
+	RefMatcherTypeBranch        = "BRANCH"
+	RefMatcherTypePattern       = "PATTERN"
+	RefMatcherTypeModelCategory = "MODEL_CATEGORY"
+	RefMatcherTypeModelBranch   = "MODEL_BRANCH"
+)
+
+// DefaultReviewersService handles communication with the default reviewers
+// related methods of the Bitbucket Server API.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-default-reviewers-rest.html
+type DefaultReviewersService service
+
+// RefMatcher matches the refs (i.e., branches and tags) a rule applies to.
+type RefMatcher struct {
+	// ID the value to match, its meaning depends on the Type. E.g., refs/heads/master
+	// for BRANCH, release/* for PATTERN, FEATURE for MODEL_CATEGORY and
+	// production for MODEL_BRANCH.
+	ID        string          `json:"id,omitempty"`
+	DisplayID string          `json:"displayId,omitempty"`
+	Type      *RefMatcherType `json:"type,omitempty"`
+	Active    bool            `json:"active,omitempty"`
+}
+
+type RefMatcherType struct {
+	// ID either ANY_REF, BRANCH, PATTERN, MODEL_CATEGORY or MODEL_BRANCH.
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// DefaultReviewersCondition represents a rule that adds reviewers to the pull
+// requests whose source and target refs match the condition.
+type DefaultReviewersCondition struct {
+	ID                int         `json:"id,omitempty"`
+	Scope             *Scope      `json:"scope,omitempty"`
+	SourceRefMatcher  *RefMatcher `json:"sourceRefMatcher,omitempty"`
+	TargetRefMatcher  *RefMatcher `json:"targetRefMatcher,omitempty"`
+	Reviewers         []*User     `json:"reviewers,omitempty"`
+	RequiredApprovals int         `json:"requiredApprovals,omitempty"`
+}
+
+// DefaultReviewersConditionRequest is the payload used to create or update a
+// default reviewers condition.
+type DefaultReviewersConditionRequest struct {
+	SourceMatcher *RefMatcher `json:"sourceMatcher"`
+	TargetMatcher *RefMatcher `json:"targetMatcher"`
+
+	// Reviewers the users to add as reviewers, only their ID is required.
+	Reviewers []*User `json:"reviewers"`
+
+	// RequiredApprovals the number of the reviewers who must approve the pull request.
+	RequiredApprovals int `json:"requiredApprovals"`
+}
+
+// ListProjectConditions retrieves the default reviewers conditions of a project.
+func (s *DefaultReviewersService) ListProjectConditions(ctx context.Context, projectKey string) ([]*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/conditions", projectKey))
+	return s.listConditions(ctx, u)
+}
+
+// ListConditions retrieves the default reviewers conditions of a repository,
+// including the ones inherited from its project.
+func (s *DefaultReviewersService) ListConditions(ctx context.Context, projectKey, repositorySlug string) ([]*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/repos/%s/conditions", projectKey, repositorySlug))
+	return s.listConditions(ctx, u)
+}
+
+func (s *DefaultReviewersService) listConditions(ctx context.Context, u string) ([]*DefaultReviewersCondition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conditions []*DefaultReviewersCondition
+	resp, err := s.client.Do(req, &conditions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return conditions, resp, nil
+}
+
+// CreateProjectCondition creates a default reviewers condition for a project.
+func (s *DefaultReviewersService) CreateProjectCondition(ctx context.Context, projectKey string, condition *DefaultReviewersConditionRequest) (*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/condition", projectKey))
+	return s.saveCondition(ctx, "POST", u, condition)
+}
+
+// CreateCondition creates a default reviewers condition for a repository.
+func (s *DefaultReviewersService) CreateCondition(ctx context.Context, projectKey, repositorySlug string, condition *DefaultReviewersConditionRequest) (*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/repos/%s/condition", projectKey, repositorySlug))
+	return s.saveCondition(ctx, "POST", u, condition)
+}
+
+// UpdateProjectCondition updates a default reviewers condition of a project.
+func (s *DefaultReviewersService) UpdateProjectCondition(ctx context.Context, projectKey string, id int, condition *DefaultReviewersConditionRequest) (*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/condition/%v", projectKey, id))
+	return s.saveCondition(ctx, "PUT", u, condition)
+}
+
+// UpdateCondition updates a default reviewers condition of a repository.
+func (s *DefaultReviewersService) UpdateCondition(ctx context.Context, projectKey, repositorySlug string, id int, condition *DefaultReviewersConditionRequest) (*DefaultReviewersCondition, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/repos/%s/condition/%v", projectKey, repositorySlug, id))
+	return s.saveCondition(ctx, "PUT", u, condition)
+}
+
+func (s *DefaultReviewersService) saveCondition(ctx context.Context, method, u string, condition *DefaultReviewersConditionRequest) (*DefaultReviewersCondition, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, u, condition)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(DefaultReviewersCondition)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// DeleteProjectCondition deletes a default reviewers condition of a project.
+func (s *DefaultReviewersService) DeleteProjectCondition(ctx context.Context, projectKey string, id int) (*Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/condition/%v", projectKey, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteCondition deletes a default reviewers condition of a repository.
+func (s *DefaultReviewersService) DeleteCondition(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/repos/%s/condition/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ComputeReviewersOptions specifies the parameters to the
+// DefaultReviewersService.ComputeReviewers method.
+type ComputeReviewersOptions struct {
+	// SourceRepoID the ID of the repository the pull request comes from.
+	SourceRepoID int `url:"sourceRepoId"`
+
+	// TargetRepoID the ID of the repository the pull request targets.
+	TargetRepoID int `url:"targetRepoId"`
+
+	// SourceRefID the ID of the source ref, e.g. refs/heads/feature.
+	SourceRefID string `url:"sourceRefId"`
+
+	// TargetRefID the ID of the target ref, e.g. refs/heads/master.
+	TargetRefID string `url:"targetRefId"`
+}
+
+// ComputeReviewers retrieves the default reviewers of a pull request from the
+// given source to the given target, according to the conditions of the target repository.
+func (s *DefaultReviewersService) ComputeReviewers(ctx context.Context, projectKey, repositorySlug string, opts *ComputeReviewersOptions) ([]*User, *Response, error) {
+	u := restURL("default-reviewers/1.0", fmt.Sprintf("projects/%s/repos/%s/reviewers", projectKey, repositorySlug))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
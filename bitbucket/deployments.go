@@ -0,0 +1,122 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	DeploymentStatePending    = "PENDING"
+	DeploymentStateInProgress = "IN_PROGRESS"
+	DeploymentStateCancelled  = "CANCELLED"
+	DeploymentStateFailed     = "FAILED"
+	DeploymentStateRolledBack = "ROLLED_BACK"
+	DeploymentStateSuccessful = "SUCCESSFUL"
+	DeploymentStateUnknown    = "UNKNOWN"
+
+	EnvironmentTypeDevelopment = "DEVELOPMENT"
+	EnvironmentTypeTesting     = "TESTING"
+	EnvironmentTypeStaging     = "STAGING"
+	EnvironmentTypeProduction  = "PRODUCTION"
+)
+
+// DeploymentsService handles communication with the deployments related
+// methods of the Bitbucket Server API. Deployments are supported since
+// Bitbucket Data Center 7.17.
+//
+// Bitbucket Data Center API doc: https://developer.atlassian.com/server/bitbucket/rest/v810/api-group-builds-and-deployments/
+type DeploymentsService service
+
+// Deployment represents a deployment of a commit to an environment. A deployment is
+// identified by its Key, its environment key and its DeploymentSequenceNumber.
+type Deployment struct {
+	// Key a unique identifier of the deployment, e.g. the CD plan key.
+	Key string `json:"key,omitempty"`
+
+	// DeploymentSequenceNumber orders the deployments with the same key and environment.
+	DeploymentSequenceNumber int64 `json:"deploymentSequenceNumber,omitempty"`
+
+	// State either PENDING, IN_PROGRESS, CANCELLED, FAILED, ROLLED_BACK, SUCCESSFUL or UNKNOWN.
+	State string `json:"state,omitempty"`
+
+	DisplayName string                 `json:"displayName,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	URL         string                 `json:"url,omitempty"`
+	Environment *DeploymentEnvironment `json:"environment,omitempty"`
+	LastUpdated Time                   `json:"lastUpdated,omitempty"`
+	FromCommit  *Commit                `json:"fromCommit,omitempty"`
+	Repository  *Repository            `json:"repository,omitempty"`
+}
+
+type DeploymentEnvironment struct {
+	Key         string `json:"key,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Type (optional) either DEVELOPMENT, TESTING, STAGING or PRODUCTION.
+	Type string `json:"type,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// DeploymentOptions identifies a deployment of a commit.
+type DeploymentOptions struct {
+	Key                      string `url:"key"`
+	EnvironmentKey           string `url:"environmentKey"`
+	DeploymentSequenceNumber int64  `url:"deploymentSequenceNumber"`
+}
+
+// Create reports a deployment of a commit, or updates the deployment if one with
+// the same key, environment key and sequence number already exists.
+func (s *DeploymentsService) Create(ctx context.Context, projectKey, repositorySlug, commitID string, deployment *Deployment) (*Deployment, *Response, error) {
+	u := restURL("api/latest", fmt.Sprintf("projects/%s/repos/%s/commits/%s/deployments", projectKey, repositorySlug, commitID))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, deployment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(Deployment)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// Get retrieves a deployment of a commit.
+func (s *DeploymentsService) Get(ctx context.Context, projectKey, repositorySlug, commitID string, opts *DeploymentOptions) (*Deployment, *Response, error) {
+	u := restURL("api/latest", fmt.Sprintf("projects/%s/repos/%s/commits/%s/deployments", projectKey, repositorySlug, commitID))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	d := new(Deployment)
+	resp, err := s.client.Do(req, d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, nil
+}
+
+// Delete deletes a deployment of a commit.
+func (s *DeploymentsService) Delete(ctx context.Context, projectKey, repositorySlug, commitID string, opts *DeploymentOptions) (*Response, error) {
+	u := restURL("api/latest", fmt.Sprintf("projects/%s/repos/%s/commits/%s/deployments", projectKey, repositorySlug, commitID))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,89 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// GPGKey represents a public GPG key used to verify the signatures of commits and tags.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-gpg-rest.html
+type GPGKey struct {
+	ID           string       `json:"id,omitempty"`
+	Fingerprint  string       `json:"fingerprint,omitempty"`
+	EmailAddress string       `json:"emailAddress,omitempty"`
+	ExpiryDate   Time         `json:"expiryDate,omitempty"`
+	Text         string       `json:"text,omitempty"`
+	SubKeys      []*GPGSubKey `json:"subKeys,omitempty"`
+}
+
+// GPGSubKey represents a sub key of a GPG key.
+type GPGSubKey struct {
+	Fingerprint string `json:"fingerprint,omitempty"`
+	ExpiryDate  Time   `json:"expiryDate,omitempty"`
+}
+
+// ListGPGKeysOptions specifies the optional parameters to the KeysService.ListGPGKeys method.
+type ListGPGKeysOptions struct {
+	// User (optional, defaults to the authenticated user) the slug of the user whose
+	// keys are listed, listing the keys of other users requires ADMIN permission.
+	User string `url:"user,omitempty"`
+
+	ListOptions
+}
+
+// ListGPGKeys retrieves a page of the GPG keys of a user.
+func (s *KeysService) ListGPGKeys(ctx context.Context, opts *ListGPGKeysOptions) ([]*GPGKey, *Response, error) {
+	u, err := addOptions(restURL("gpg/1.0", "keys"), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*GPGKey
+	page := &pagedResponse{
+		Values: &keys,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+type gpgKeyText struct {
+	Text string `json:"text"`
+}
+
+// CreateGPGKey uploads an ASCII-armored public GPG key for the authenticated user.
+func (s *KeysService) CreateGPGKey(ctx context.Context, armoredKey string) (*GPGKey, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", restURL("gpg/1.0", "keys"), &gpgKeyText{Text: armoredKey})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, nil
+}
+
+// DeleteGPGKey deletes a GPG key, identified by either its ID or its fingerprint.
+func (s *KeysService) DeleteGPGKey(ctx context.Context, fingerprintOrID string) (*Response, error) {
+	u := restURL("gpg/1.0", fmt.Sprintf("keys/%s", fingerprintOrID))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,200 @@
+package bitbucket
+
+import "context"
+
+// GroupsService handles communication with the group administration related
+// methods of the Bitbucket Server API. The methods require ADMIN permission.
+type GroupsService service
+
+type Group struct {
+	Name      string `json:"name,omitempty"`
+	Deletable bool   `json:"deletable,omitempty"`
+}
+
+// ListGroupsOptions specifies the optional parameters to the GroupsService.List method.
+type ListGroupsOptions struct {
+	// Filter (optional) return only groups whose name contains this value.
+	Filter string `url:"filter,omitempty"`
+
+	ListOptions
+}
+
+// List retrieves a page of groups.
+func (s *GroupsService) List(ctx context.Context, opts *ListGroupsOptions) ([]*Group, *Response, error) {
+	u, err := addOptions("admin/groups", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	page := &pagedResponse{
+		Values: &groups,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
+
+type groupNameOptions struct {
+	Name string `url:"name"`
+}
+
+// Create creates a group.
+func (s *GroupsService) Create(ctx context.Context, name string) (*Group, *Response, error) {
+	u, err := addOptions("admin/groups", &groupNameOptions{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// Delete deletes a group and returns it.
+func (s *GroupsService) Delete(ctx context.Context, name string) (*Group, *Response, error) {
+	u, err := addOptions("admin/groups", &groupNameOptions{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(Group)
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// ListMembershipOptions specifies the optional parameters to the GroupsService
+// methods that list the members of a group or the groups of a user.
+type ListMembershipOptions struct {
+	// Filter (optional) return only the users (or the groups) whose name contains this value.
+	Filter string `url:"filter,omitempty"`
+
+	ListOptions
+}
+
+type membershipOptions struct {
+	Context string `url:"context"`
+
+	ListMembershipOptions
+}
+
+func newMembershipOptions(name string, opts *ListMembershipOptions) *membershipOptions {
+	v := &membershipOptions{Context: name}
+	if opts != nil {
+		v.ListMembershipOptions = *opts
+	}
+	return v
+}
+
+// ListMembers retrieves a page of the users who are members of a group.
+func (s *GroupsService) ListMembers(ctx context.Context, group string, opts *ListMembershipOptions) ([]*User, *Response, error) {
+	return s.listUsers(ctx, "admin/groups/more-members", group, opts)
+}
+
+// ListNonMembers retrieves a page of the users who are not members of a group.
+func (s *GroupsService) ListNonMembers(ctx context.Context, group string, opts *ListMembershipOptions) ([]*User, *Response, error) {
+	return s.listUsers(ctx, "admin/groups/more-non-members", group, opts)
+}
+
+func (s *GroupsService) listUsers(ctx context.Context, u, group string, opts *ListMembershipOptions) ([]*User, *Response, error) {
+	u, err := addOptions(u, newMembershipOptions(group, opts))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	page := &pagedResponse{
+		Values: &users,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// ListUserGroups retrieves a page of the groups the user with the userName is a member of.
+func (s *GroupsService) ListUserGroups(ctx context.Context, userName string, opts *ListMembershipOptions) ([]*Group, *Response, error) {
+	u, err := addOptions("admin/users/more-members", newMembershipOptions(userName, opts))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []*Group
+	page := &pagedResponse{
+		Values: &groups,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
+
+type groupUsers struct {
+	Group string   `json:"group"`
+	Users []string `json:"users"`
+}
+
+// AddUsers adds the users with the userNames to a group.
+func (s *GroupsService) AddUsers(ctx context.Context, group string, userNames []string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "admin/groups/add-users", &groupUsers{Group: group, Users: userNames})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+type groupUser struct {
+	Context  string `json:"context"`
+	ItemName string `json:"itemName"`
+}
+
+// RemoveUser removes the user with the userName from a group.
+func (s *GroupsService) RemoveUser(ctx context.Context, group, userName string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "admin/groups/remove-user", &groupUser{Context: group, ItemName: userName})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,344 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ReportResultPass = "PASS"
+	ReportResultFail = "FAIL"
+
+	ReportDataTypeBoolean    = "BOOLEAN"
+	ReportDataTypeDate       = "DATE"
+	ReportDataTypeDuration   = "DURATION"
+	ReportDataTypeLink       = "LINK"
+	ReportDataTypeNumber     = "NUMBER"
+	ReportDataTypePercentage = "PERCENTAGE"
+	ReportDataTypeText       = "TEXT"
+
+	AnnotationSeverityLow    = "LOW"
+	AnnotationSeverityMedium = "MEDIUM"
+	AnnotationSeverityHigh   = "HIGH"
+
+	AnnotationTypeVulnerability = "VULNERABILITY"
+	AnnotationTypeCodeSmell     = "CODE_SMELL"
+	AnnotationTypeBug           = "BUG"
+)
+
+// InsightsService handles communication with the Code Insights related
+// methods of the Bitbucket Server API.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-code-insights-rest.html
+type InsightsService service
+
+// InsightReport represents a Code Insights report, e.g. the result of a static analysis, on a commit.
+type InsightReport struct {
+	Key         string               `json:"key,omitempty"`
+	Title       string               `json:"title,omitempty"`
+	Details     string               `json:"details,omitempty"`
+	Result      string               `json:"result,omitempty"` // either PASS or FAIL
+	Reporter    string               `json:"reporter,omitempty"`
+	Link        string               `json:"link,omitempty"`
+	LogoURL     string               `json:"logoUrl,omitempty"`
+	Data        []*InsightReportData `json:"data,omitempty"`
+	CreatedDate Time                 `json:"createdDate,omitempty"`
+}
+
+// InsightReportData is a field displayed in a report. The Value depends on the Type:
+//   - BOOLEAN: bool
+//   - DATE: the milliseconds since the epoch
+//   - DURATION: the duration in milliseconds
+//   - LINK: *InsightReportLink
+//   - NUMBER: a number
+//   - PERCENTAGE: a number between 0 and 100
+//   - TEXT: string
+type InsightReportData struct {
+	Title string      `json:"title"`
+	Type  string      `json:"type,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+// InsightReportLink is the value of a report data field of LINK type.
+type InsightReportLink struct {
+	LinkText string `json:"linktext,omitempty"`
+	Href     string `json:"href"`
+}
+
+// InsightAnnotation represents a finding, reported as part of a report, on a line of a file.
+type InsightAnnotation struct {
+	// ExternalID (optional) an ID used to update or delete the annotation.
+	ExternalID string `json:"externalId,omitempty"`
+
+	// Path the path of the file, the annotation will be on the file if it is empty.
+	Path string `json:"path,omitempty"`
+
+	// Line the line of the file, zero means the annotation is on the whole file.
+	Line int `json:"line,omitempty"`
+
+	Message string `json:"message"`
+
+	// Severity either LOW, MEDIUM or HIGH.
+	Severity string `json:"severity"`
+
+	// Type (optional) either VULNERABILITY, CODE_SMELL or BUG.
+	Type string `json:"type,omitempty"`
+
+	Link string `json:"link,omitempty"`
+
+	// ReportKey this populated only when the annotations are listed for a pull request.
+	ReportKey string `json:"reportKey,omitempty"`
+}
+
+type insightAnnotations struct {
+	TotalCount  int                  `json:"totalCount,omitempty"`
+	Annotations []*InsightAnnotation `json:"annotations"`
+}
+
+// CreateReport creates a report on a commit, or replaces the report if one with
+// the same key already exists.
+func (s *InsightsService) CreateReport(ctx context.Context, projectKey, repositorySlug, commitID, key string, report *InsightReport) (*InsightReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s", projectKey, repositorySlug, commitID, key))
+
+	req, err := s.client.NewRequest(ctx, "PUT", u, report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(InsightReport)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// GetReport retrieves a report of a commit.
+func (s *InsightsService) GetReport(ctx context.Context, projectKey, repositorySlug, commitID, key string) (*InsightReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s", projectKey, repositorySlug, commitID, key))
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(InsightReport)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// ListReports retrieves a page of the reports of a commit.
+func (s *InsightsService) ListReports(ctx context.Context, projectKey, repositorySlug, commitID string, opts *ListOptions) ([]*InsightReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports", projectKey, repositorySlug, commitID))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reports []*InsightReport
+	page := &pagedResponse{
+		Values: &reports,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reports, resp, nil
+}
+
+// DeleteReport deletes a report of a commit with its annotations.
+func (s *InsightsService) DeleteReport(ctx context.Context, projectKey, repositorySlug, commitID, key string) (*Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s", projectKey, repositorySlug, commitID, key))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// AddAnnotations adds annotations to a report in bulk. A report can hold up to 1000 annotations.
+func (s *InsightsService) AddAnnotations(ctx context.Context, projectKey, repositorySlug, commitID, key string, annotations []*InsightAnnotation) (*Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s/annotations", projectKey, repositorySlug, commitID, key))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, &insightAnnotations{Annotations: annotations})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListAnnotations retrieves the annotations of a report.
+func (s *InsightsService) ListAnnotations(ctx context.Context, projectKey, repositorySlug, commitID, key string) ([]*InsightAnnotation, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s/annotations", projectKey, repositorySlug, commitID, key))
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(insightAnnotations)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v.Annotations, resp, nil
+}
+
+// DeleteAnnotationsOptions specifies the optional parameters to the
+// InsightsService.DeleteAnnotations method.
+type DeleteAnnotationsOptions struct {
+	// ExternalID (optional) delete only the annotations with these external IDs,
+	// all the annotations of the report are deleted if it is empty.
+	ExternalID []string `url:"externalId,omitempty"`
+}
+
+// DeleteAnnotations deletes annotations of a report.
+func (s *InsightsService) DeleteAnnotations(ctx context.Context, projectKey, repositorySlug, commitID, key string, opts *DeleteAnnotationsOptions) (*Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/commits/%s/reports/%s/annotations", projectKey, repositorySlug, commitID, key))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListPullRequestAnnotationsOptions specifies the optional parameters to the
+// InsightsService.ListPullRequestAnnotations method.
+type ListPullRequestAnnotationsOptions struct {
+	// ExternalID (optional) return only the annotations with these external IDs.
+	ExternalID []string `url:"externalId,omitempty"`
+
+	// Key (optional) return only the annotations of the reports with these keys.
+	Key []string `url:"key,omitempty"`
+
+	// Path (optional) return only the annotations on these paths.
+	Path []string `url:"path,omitempty"`
+
+	// Severity (optional) return only the annotations with these severities.
+	Severity []string `url:"severity,omitempty"`
+
+	// Type (optional) return only the annotations of these types.
+	Type []string `url:"type,omitempty"`
+}
+
+// ListPullRequestAnnotations retrieves the annotations of all the reports on the
+// latest commit of a pull request, limited to the files changed by the pull request.
+func (s *InsightsService) ListPullRequestAnnotations(ctx context.Context, projectKey, repositorySlug string, id int, opts *ListPullRequestAnnotationsOptions) ([]*InsightAnnotation, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/annotations", projectKey, repositorySlug, id))
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(insightAnnotations)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v.Annotations, resp, nil
+}
+
+// RequiredReport represents a merge check that requires a report to exist, and
+// optionally to pass, on the latest commit of a pull request before it can be merged.
+type RequiredReport struct {
+	ID        int    `json:"id,omitempty"`
+	ReportKey string `json:"reportKey"`
+	MustPass  bool   `json:"mustPass"`
+
+	// AnnotationSeverity (optional) the pull request can not be merged if the report
+	// has annotations of this severity or higher on the changed files.
+	AnnotationSeverity string `json:"annotationSeverity,omitempty"`
+}
+
+// ListRequiredReports retrieves the required reports merge checks of a repository.
+func (s *InsightsService) ListRequiredReports(ctx context.Context, projectKey, repositorySlug string) ([]*RequiredReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/conditions", projectKey, repositorySlug))
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reports []*RequiredReport
+	resp, err := s.client.Do(req, &reports)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return reports, resp, nil
+}
+
+// CreateRequiredReport adds a required report merge check to a repository.
+func (s *InsightsService) CreateRequiredReport(ctx context.Context, projectKey, repositorySlug string, report *RequiredReport) (*RequiredReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/conditions", projectKey, repositorySlug))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RequiredReport)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// UpdateRequiredReport updates a required report merge check of a repository.
+func (s *InsightsService) UpdateRequiredReport(ctx context.Context, projectKey, repositorySlug string, id int, report *RequiredReport) (*RequiredReport, *Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/conditions/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "PUT", u, report)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := new(RequiredReport)
+	resp, err := s.client.Do(req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return r, resp, nil
+}
+
+// DeleteRequiredReport deletes a required report merge check of a repository.
+func (s *InsightsService) DeleteRequiredReport(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := restURL("insights/1.0", fmt.Sprintf("projects/%s/repos/%s/conditions/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,225 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// KeysService handles communication with the SSH keys and access keys related
+// methods of the Bitbucket Server API.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-ssh-rest.html
+type KeysService service
+
+// SSHKey represents a public SSH key.
+type SSHKey struct {
+	ID    int    `json:"id,omitempty"`
+	Text  string `json:"text,omitempty"`
+	Label string `json:"label,omitempty"`
+}
+
+// AccessKey represents an SSH key granted access to a repository or a project.
+type AccessKey struct {
+	Key        *SSHKey     `json:"key,omitempty"`
+	Permission string      `json:"permission,omitempty"`
+	Repository *Repository `json:"repository,omitempty"` // this populated only for repository access keys
+	Project    *Project    `json:"project,omitempty"`    // this populated only for project access keys
+}
+
+// ListSSHKeysOptions specifies the optional parameters to the KeysService.ListSSHKeys method.
+type ListSSHKeysOptions struct {
+	// User (optional, defaults to the authenticated user) the slug of the user whose
+	// keys are listed, listing the keys of other users requires ADMIN permission.
+	User string `url:"user,omitempty"`
+
+	ListOptions
+}
+
+// ListSSHKeys retrieves a page of the SSH keys of a user.
+func (s *KeysService) ListSSHKeys(ctx context.Context, opts *ListSSHKeysOptions) ([]*SSHKey, *Response, error) {
+	u, err := addOptions(restURL("ssh/1.0", "keys"), opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*SSHKey
+	page := &pagedResponse{
+		Values: &keys,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+type sshKeyUserOptions struct {
+	User string `url:"user,omitempty"`
+}
+
+// CreateSSHKey adds an SSH key to the user with the userSlug, or to the authenticated
+// user if userSlug is empty. Only Text and Label of the key are used.
+func (s *KeysService) CreateSSHKey(ctx context.Context, userSlug string, key *SSHKey) (*SSHKey, *Response, error) {
+	u, err := addOptions(restURL("ssh/1.0", "keys"), &sshKeyUserOptions{User: userSlug})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", u, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(SSHKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, nil
+}
+
+// DeleteSSHKey deletes an SSH key.
+func (s *KeysService) DeleteSSHKey(ctx context.Context, id int) (*Response, error) {
+	u := restURL("ssh/1.0", fmt.Sprintf("keys/%v", id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListAccessKeysOptions specifies the optional parameters to the KeysService
+// methods that list access keys.
+type ListAccessKeysOptions struct {
+	// Filter (optional) return only the keys whose label contains this value.
+	Filter string `url:"filter,omitempty"`
+
+	// Permission (optional) return only the keys with this permission.
+	Permission string `url:"permission,omitempty"`
+
+	ListOptions
+}
+
+// ListRepositoryAccessKeys retrieves a page of the access keys of a repository.
+func (s *KeysService) ListRepositoryAccessKeys(ctx context.Context, projectKey, repositorySlug string, opts *ListAccessKeysOptions) ([]*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/repos/%s/ssh", projectKey, repositorySlug))
+	return s.listAccessKeys(ctx, u, opts)
+}
+
+// ListProjectAccessKeys retrieves a page of the access keys of a project.
+func (s *KeysService) ListProjectAccessKeys(ctx context.Context, projectKey string, opts *ListAccessKeysOptions) ([]*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/ssh", projectKey))
+	return s.listAccessKeys(ctx, u, opts)
+}
+
+func (s *KeysService) listAccessKeys(ctx context.Context, u string, opts *ListAccessKeysOptions) ([]*AccessKey, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []*AccessKey
+	page := &pagedResponse{
+		Values: &keys,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+// AddRepositoryAccessKey grants an SSH key access to a repository with the permission,
+// either REPO_READ or REPO_WRITE. The key is created if it does not exist.
+func (s *KeysService) AddRepositoryAccessKey(ctx context.Context, projectKey, repositorySlug string, key *SSHKey, permission string) (*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/repos/%s/ssh", projectKey, repositorySlug))
+	return s.addAccessKey(ctx, u, key, permission)
+}
+
+// AddProjectAccessKey grants an SSH key access to a project with the permission,
+// either PROJECT_READ or PROJECT_WRITE. The key is created if it does not exist.
+func (s *KeysService) AddProjectAccessKey(ctx context.Context, projectKey string, key *SSHKey, permission string) (*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/ssh", projectKey))
+	return s.addAccessKey(ctx, u, key, permission)
+}
+
+func (s *KeysService) addAccessKey(ctx context.Context, u string, key *SSHKey, permission string) (*AccessKey, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", u, &AccessKey{Key: key, Permission: permission})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(AccessKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, nil
+}
+
+// UpdateRepositoryAccessKeyPermission changes the permission of an access key of a repository.
+func (s *KeysService) UpdateRepositoryAccessKeyPermission(ctx context.Context, projectKey, repositorySlug string, id int, permission string) (*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/repos/%s/ssh/%v/permission/%s", projectKey, repositorySlug, id, permission))
+	return s.updateAccessKeyPermission(ctx, u)
+}
+
+// UpdateProjectAccessKeyPermission changes the permission of an access key of a project.
+func (s *KeysService) UpdateProjectAccessKeyPermission(ctx context.Context, projectKey string, id int, permission string) (*AccessKey, *Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/ssh/%v/permission/%s", projectKey, id, permission))
+	return s.updateAccessKeyPermission(ctx, u)
+}
+
+func (s *KeysService) updateAccessKeyPermission(ctx context.Context, u string) (*AccessKey, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PUT", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(AccessKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, nil
+}
+
+// DeleteRepositoryAccessKey revokes the access of an SSH key to a repository.
+func (s *KeysService) DeleteRepositoryAccessKey(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/repos/%s/ssh/%v", projectKey, repositorySlug, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteProjectAccessKey revokes the access of an SSH key to a project.
+func (s *KeysService) DeleteProjectAccessKey(ctx context.Context, projectKey string, id int) (*Response, error) {
+	u := restURL("keys/1.0", fmt.Sprintf("projects/%s/ssh/%v", projectKey, id))
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -1,5 +1,15 @@
 package bitbucket
 
+// ProjectsService handles communication with the project related
+// methods of the Bitbucket Server API.
+type ProjectsService service
+
+const (
+	PermissionProjectRead  = "PROJECT_READ"
+	PermissionProjectWrite = "PROJECT_WRITE"
+	PermissionProjectAdmin = "PROJECT_ADMIN"
+)
+
 type Project struct {
 	Key         string     `json:"key,omitempty"`
 	Id          int        `json:"id,omitempty"`
@@ -0,0 +1,98 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListHooks retrieves a page of the hooks of a project.
+func (s *ProjectsService) ListHooks(ctx context.Context, projectKey string, opts *ListHooksOptions) ([]*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks", projectKey)
+	return listHooks(ctx, s.client, u, opts)
+}
+
+// GetHook retrieves a hook of a project.
+func (s *ProjectsService) GetHook(ctx context.Context, projectKey, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks/%s", projectKey, hookKey)
+	return doHook(ctx, s.client, "GET", u)
+}
+
+// EnableHook enables a hook in a project, it applies to the repositories that
+// do not override it.
+func (s *ProjectsService) EnableHook(ctx context.Context, projectKey, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks/%s/enabled", projectKey, hookKey)
+	return doHook(ctx, s.client, "PUT", u)
+}
+
+// DisableHook disables a hook in a project.
+func (s *ProjectsService) DisableHook(ctx context.Context, projectKey, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks/%s/enabled", projectKey, hookKey)
+	return doHook(ctx, s.client, "DELETE", u)
+}
+
+// GetHookSettings retrieves the settings of a hook in a project.
+func (s *ProjectsService) GetHookSettings(ctx context.Context, projectKey, hookKey string) (HookSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks/%s/settings", projectKey, hookKey)
+	return doHookSettings(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateHookSettings replaces the settings of a hook in a project.
+func (s *ProjectsService) UpdateHookSettings(ctx context.Context, projectKey, hookKey string, settings HookSettings) (HookSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/hooks/%s/settings", projectKey, hookKey)
+	return doHookSettings(ctx, s.client, "PUT", u, settings)
+}
+
+// CreateWebHook creates a webhook in a project, it fires for the events of all
+// the repositories in the project.
+func (s *ProjectsService) CreateWebHook(ctx context.Context, projectKey string, hook *WebHook) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks", projectKey)
+	return doWebHook(ctx, s.client, "POST", u, hook)
+}
+
+// ListWebHooks retrieves a page of the webhooks of a project.
+func (s *ProjectsService) ListWebHooks(ctx context.Context, projectKey string, opts *WebHookListOptions) ([]*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks", projectKey)
+	return listWebHooks(ctx, s.client, u, opts)
+}
+
+// GetWebHook retrieves a webhook of a project.
+func (s *ProjectsService) GetWebHook(ctx context.Context, projectKey string, id int, opts *WebHookOptions) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks/%v", projectKey, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doWebHook(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateWebHook updates a webhook of a project.
+func (s *ProjectsService) UpdateWebHook(ctx context.Context, projectKey string, id int, hook *WebHook) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks/%v", projectKey, id)
+	return doWebHook(ctx, s.client, "PUT", u, hook)
+}
+
+// DeleteWebHook deletes a webhook of a project.
+func (s *ProjectsService) DeleteWebHook(ctx context.Context, projectKey string, id int) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks/%v", projectKey, id)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// GetWebHookStatistics retrieves the latest invocations of a webhook of a project.
+func (s *ProjectsService) GetWebHookStatistics(ctx context.Context, projectKey string, id int) (*WebHookStatistics, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks/%v/statistics", projectKey, id)
+	return getWebHookStatistics(ctx, s.client, u)
+}
+
+// TestWebHook tests the connection of a project to a webhook target, and returns
+// the request sent and the response received.
+func (s *ProjectsService) TestWebHook(ctx context.Context, projectKey string, opts *TestWebHookOptions) (*WebHookTestResult, *Response, error) {
+	u := fmt.Sprintf("projects/%s/webhooks/test", projectKey)
+	return testWebHook(ctx, s.client, u, opts)
+}
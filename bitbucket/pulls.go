@@ -22,15 +22,25 @@ type PullRequest struct {
 	Closed       bool               `json:"closed,omitempty"`
 	CreatedDate  Time               `json:"createdDate,omitempty"`
 	UpdatedDate  Time               `json:"updatedDate,omitempty"`
-	FromRef      *PullRequestRef    `json:"from_ref,omitempty"`
+	FromRef      *PullRequestRef    `json:"fromRef,omitempty"`
 	ToRef        *PullRequestRef    `json:"toRef,omitempty"`
 	Locked       bool               `json:"locked,omitempty"`
+	Draft        bool               `json:"draft,omitempty"` // this supported only since Bitbucket Data Center 8.18
 	Author       *PullRequestUser   `json:"author,omitempty"`
 	Reviewers    []*PullRequestUser `json:"reviewers,omitempty"`
 	Participants []*PullRequestUser `json:"participants,omitempty"`
+	Properties   *PullRequestProps  `json:"properties,omitempty"`
 	Links        *SelfLinks         `json:"links,omitempty"`
 }
 
+// PullRequestProps holds the additional properties of a pull request, they are
+// returned only when requested (see PullRequestListOptions.WithProperties).
+type PullRequestProps struct {
+	CommentCount      int `json:"commentCount,omitempty"`
+	OpenTaskCount     int `json:"openTaskCount,omitempty"`
+	ResolvedTaskCount int `json:"resolvedTaskCount,omitempty"`
+}
+
 type PullRequestRef struct {
 	ID           string      `json:"id,omitempty"`
 	DisplayId    string      `json:"displayId,omitempty"`
@@ -121,3 +131,54 @@ func (s *PullRequestsService) Get(ctx context.Context, projectKey, repo string,
 
 	return pull, resp, nil
 }
+
+// DashboardPullRequestListOptions specifies the optional parameters to the
+// PullRequestsService.ListDashboard method.
+type DashboardPullRequestListOptions struct {
+	// State (optional) if specified, only pull requests in the specified state will be returned.
+	// Either OPEN, DECLINED or MERGED. Omit this parameter to return pull request in any state.
+	State string `url:"state,omitempty"`
+
+	// Role (optional) if specified, only pull requests where the authenticated user is
+	// participating in the given role will be returned. Either AUTHOR, REVIEWER or PARTICIPANT.
+	Role string `url:"role,omitempty"`
+
+	// ParticipantStatus (optional) if specified, only pull requests where the authenticated
+	// user has the given status will be returned. Either APPROVED, NEEDS_WORK or UNAPPROVED.
+	ParticipantStatus string `url:"participantStatus,omitempty"`
+
+	// Order (optional, defaults to NEWEST) the order to return pull requests in,
+	// either OLDEST (as in: "oldest first") or NEWEST.
+	Order string `url:"order,omitempty"`
+
+	// ClosedSince (optional) if specified, only pull requests that are open or were
+	// closed within this number of seconds will be returned, e.g. 86400 for the last day.
+	ClosedSince int `url:"closedSince,omitempty"`
+
+	ListOptions
+}
+
+// ListDashboard retrieves a page of pull requests, across all repositories, where the
+// authenticated user is involved as an author, a reviewer or a participant.
+func (s *PullRequestsService) ListDashboard(ctx context.Context, opts *DashboardPullRequestListOptions) ([]*PullRequest, *Response, error) {
+	u, err := addOptions("dashboard/pull-requests", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pulls []*PullRequest
+	page := &pagedResponse{
+		Values: &pulls,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pulls, resp, nil
+}
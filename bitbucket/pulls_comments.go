@@ -0,0 +1,172 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	LineTypeAdded   = "ADDED"
+	LineTypeRemoved = "REMOVED"
+	LineTypeContext = "CONTEXT"
+
+	FileTypeFrom = "FROM"
+	FileTypeTo   = "TO"
+
+	CommentSeverityNormal  = "NORMAL"
+	CommentSeverityBlocker = "BLOCKER"
+
+	CommentStateOpen     = "OPEN"
+	CommentStateResolved = "RESOLVED"
+)
+
+// Comment represents a comment on a pull request. Replies to the comment are
+// populated in Comments when the comment is retrieved as a thread.
+type Comment struct {
+	ID          int            `json:"id,omitempty"`
+	Version     int            `json:"version,omitempty"`
+	Text        string         `json:"text,omitempty"`
+	Author      *User          `json:"author,omitempty"`
+	CreatedDate Time           `json:"createdDate,omitempty"`
+	UpdatedDate Time           `json:"updatedDate,omitempty"`
+	Comments    []*Comment     `json:"comments,omitempty"`
+	Parent      *Comment       `json:"parent,omitempty"`   // this used only when replying to a comment
+	Anchor      *CommentAnchor `json:"anchor,omitempty"`   // this populated only for inline and file comments
+	Severity    string         `json:"severity,omitempty"` // either NORMAL or BLOCKER, blockers are the tasks since Bitbucket Server 7.2
+	State       string         `json:"state,omitempty"`    // either OPEN or RESOLVED
+}
+
+// CommentAnchor anchors a comment to a file, or to a line in a file when Line is specified.
+type CommentAnchor struct {
+	// Path the path of the file to comment on.
+	Path string `json:"path,omitempty"`
+
+	// SrcPath (optional) the path of the file before it was renamed or copied.
+	SrcPath string `json:"srcPath,omitempty"`
+
+	// Line (optional) the line to comment on, leave it empty to comment on the file.
+	Line int `json:"line,omitempty"`
+
+	// LineType the type of the line: ADDED, REMOVED or CONTEXT.
+	LineType string `json:"lineType,omitempty"`
+
+	// FileType the side of the diff the line belongs to: FROM or TO.
+	FileType string `json:"fileType,omitempty"`
+
+	DiffType string `json:"diffType,omitempty"`
+	FromHash string `json:"fromHash,omitempty"`
+	ToHash   string `json:"toHash,omitempty"`
+	Orphaned bool   `json:"orphaned,omitempty"`
+}
+
+// commentUpdate is the payload used to update a comment, the version is always sent
+// as zero is a valid comment version.
+type commentUpdate struct {
+	Version int    `json:"version"`
+	Text    string `json:"text,omitempty"`
+	State   string `json:"state,omitempty"`
+}
+
+// CreateComment adds a new comment to a pull request. The comment will be general
+// unless an Anchor is specified, and it will be a reply if a Parent is specified.
+func (s *PullRequestsService) CreateComment(ctx context.Context, projectKey, repo string, id int, comment *Comment) (*Comment, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/comments", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, comment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Comment)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// CreateInlineComment adds a comment on a line of a file changed by the pull request.
+// lineType should be one of LineTypeAdded, LineTypeRemoved or LineTypeContext and
+// fileType should be FileTypeFrom or FileTypeTo.
+func (s *PullRequestsService) CreateInlineComment(ctx context.Context, projectKey, repo string, id int, path string, line int, lineType, fileType, text string) (*Comment, *Response, error) {
+	comment := &Comment{
+		Text: text,
+		Anchor: &CommentAnchor{
+			Path:     path,
+			Line:     line,
+			LineType: lineType,
+			FileType: fileType,
+		},
+	}
+
+	return s.CreateComment(ctx, projectKey, repo, id, comment)
+}
+
+// ReplyToComment adds a reply to the comment with the parentID.
+func (s *PullRequestsService) ReplyToComment(ctx context.Context, projectKey, repo string, id, parentID int, text string) (*Comment, *Response, error) {
+	comment := &Comment{
+		Text:   text,
+		Parent: &Comment{ID: parentID},
+	}
+
+	return s.CreateComment(ctx, projectKey, repo, id, comment)
+}
+
+// GetComment retrieves a comment with its replies nested in the Comments field.
+func (s *PullRequestsService) GetComment(ctx context.Context, projectKey, repo string, id, commentID int) (*Comment, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/comments/%v", projectKey, repo, id, commentID)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Comment)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// EditComment updates the text of a comment. The version must match the current
+// version of the comment, otherwise the server will reject the update.
+func (s *PullRequestsService) EditComment(ctx context.Context, projectKey, repo string, id, commentID, version int, text string) (*Comment, *Response, error) {
+	return s.updateComment(ctx, projectKey, repo, id, commentID, &commentUpdate{Version: version, Text: text})
+}
+
+func (s *PullRequestsService) updateComment(ctx context.Context, projectKey, repo string, id, commentID int, update *commentUpdate) (*Comment, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/comments/%v", projectKey, repo, id, commentID)
+
+	req, err := s.client.NewRequest(ctx, "PUT", u, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Comment)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return c, resp, nil
+}
+
+// DeleteComment deletes a comment. The version must match the current version of
+// the comment. Comments that have replies cannot be deleted.
+func (s *PullRequestsService) DeleteComment(ctx context.Context, projectKey, repo string, id, commentID, version int) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/comments/%v", projectKey, repo, id, commentID)
+	u, err := addOptions(u, &versionOptions{Version: version})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
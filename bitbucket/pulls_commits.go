@@ -0,0 +1,134 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	ChangeScopeAll        = "ALL"
+	ChangeScopeUnreviewed = "UNREVIEWED"
+	ChangeScopeRange      = "RANGE"
+)
+
+// ListPullRequestCommitsOptions specifies the optional parameters to the
+// PullRequestsService.ListCommits method.
+type ListPullRequestCommitsOptions struct {
+	// WithCounts (optional, defaults to false) if true, the response will include
+	// the total number of commits and authors.
+	WithCounts bool `url:"withCounts,omitempty"`
+
+	ListOptions
+}
+
+// ListCommits retrieves a page of the commits in a pull request, newest first.
+func (s *PullRequestsService) ListCommits(ctx context.Context, projectKey, repo string, id int, opts *ListPullRequestCommitsOptions) ([]*Commit, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/commits", projectKey, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var commits []*Commit
+	page := &pagedResponse{
+		Values: &commits,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return commits, resp, nil
+}
+
+// ListPullRequestChangesOptions specifies the optional parameters to the
+// PullRequestsService.ListChanges method.
+type ListPullRequestChangesOptions struct {
+	// ChangeScope (optional, defaults to ALL) either ALL, UNREVIEWED or RANGE.
+	// UNREVIEWED returns the changes since the authenticated user's last review,
+	// or all changes if the user has not reviewed the pull request. RANGE returns
+	// the changes between SinceID and UntilID.
+	ChangeScope string `url:"changeScope,omitempty"`
+
+	// SinceID (optional) the commit to diff from, used with the RANGE scope.
+	SinceID string `url:"sinceId,omitempty"`
+
+	// UntilID (optional) the commit to diff to, used with the RANGE scope.
+	UntilID string `url:"untilId,omitempty"`
+
+	// WithComments (optional, defaults to true) whether to include the comments count of each change.
+	WithComments bool `url:"withComments,omitempty"`
+
+	ListOptions
+}
+
+// ListChanges retrieves a page of the changes made by a pull request.
+func (s *PullRequestsService) ListChanges(ctx context.Context, projectKey, repo string, id int, opts *ListPullRequestChangesOptions) ([]*Change, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/changes", projectKey, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []*Change
+	page := &pagedResponse{
+		Values: &changes,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return changes, resp, nil
+}
+
+// ListChangesSinceReview retrieves a page of the changes made by the pull request since
+// the last commit the reviewer reviewed (see PullRequestUser.LastReviewedCommit). All
+// the changes are returned if the reviewer is nil or has not reviewed the pull request yet.
+func (s *PullRequestsService) ListChangesSinceReview(ctx context.Context, pull *PullRequest, reviewer *PullRequestUser, opts *ListOptions) ([]*Change, *Response, error) {
+	if pull.ToRef == nil || pull.ToRef.Repository == nil || pull.ToRef.Repository.Project == nil {
+		return nil, nil, fmt.Errorf("pull request %v does not have its target repository populated", pull.ID)
+	}
+
+	changesOpts := &ListPullRequestChangesOptions{ChangeScope: ChangeScopeAll}
+	if opts != nil {
+		changesOpts.ListOptions = *opts
+	}
+	if reviewer != nil && reviewer.LastReviewedCommit != "" && pull.FromRef != nil {
+		changesOpts.ChangeScope = ChangeScopeRange
+		changesOpts.SinceID = reviewer.LastReviewedCommit
+		changesOpts.UntilID = pull.FromRef.LatestCommit
+	}
+
+	repo := pull.ToRef.Repository
+	return s.ListChanges(ctx, repo.Project.Key, repo.Slug, pull.ID, changesOpts)
+}
+
+// GetMergeBase retrieves the best common ancestor between the latest commits of
+// the source and target branches of a pull request.
+func (s *PullRequestsService) GetMergeBase(ctx context.Context, projectKey, repo string, id int) (*Commit, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/merge-base", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(Commit)
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return commit, resp, nil
+}
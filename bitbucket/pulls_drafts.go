@@ -0,0 +1,159 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// draftUpdate is the payload used to change the draft state of a pull request. The
+// update endpoint replaces the editable fields, so their current values are sent
+// along. Version and Draft are always sent as zero is a valid version and false is
+// a valid state.
+type draftUpdate struct {
+	Version     int                `json:"version"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description"`
+	Reviewers   []*PullRequestUser `json:"reviewers"`
+	ToRef       *PullRequestRef    `json:"toRef,omitempty"`
+	Draft       bool               `json:"draft"`
+}
+
+// Publish marks a draft pull request as ready for review. The version must match the
+// current version of the pull request.
+//
+// It fetches the pull request first to keep its title, description, reviewers and
+// target branch unchanged. The first call on a client also fetches the application
+// properties to check the server supports drafts (Bitbucket Data Center 8.18 or later).
+func (s *PullRequestsService) Publish(ctx context.Context, projectKey, repo string, id, version int) (*PullRequest, *Response, error) {
+	return s.setDraft(ctx, projectKey, repo, id, version, false)
+}
+
+// ConvertToDraft marks a pull request as a draft. The version must match the current
+// version of the pull request.
+//
+// Like Publish, it fetches the pull request first and, on the first call on a client,
+// the application properties.
+func (s *PullRequestsService) ConvertToDraft(ctx context.Context, projectKey, repo string, id, version int) (*PullRequest, *Response, error) {
+	return s.setDraft(ctx, projectKey, repo, id, version, true)
+}
+
+func (s *PullRequestsService) setDraft(ctx context.Context, projectKey, repo string, id, version int, draft bool) (*PullRequest, *Response, error) {
+	if err := s.client.requireVersion(ctx, "draft pull requests", 8, 18); err != nil {
+		return nil, nil, err
+	}
+
+	current, resp, err := s.Get(ctx, projectKey, repo, id)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	body := &draftUpdate{
+		Version:     version,
+		Title:       current.Title,
+		Description: current.Description,
+		Reviewers:   current.Reviewers,
+		ToRef:       current.ToRef,
+		Draft:       draft,
+	}
+	if body.Reviewers == nil {
+		body.Reviewers = []*PullRequestUser{}
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "PUT", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pull := new(PullRequest)
+	resp, err = s.client.Do(req, pull)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pull, resp, nil
+}
+
+// AutoMergeRequest represents a request to merge a pull request automatically once
+// its merge checks, such as the required builds, pass.
+//
+// Bitbucket Data Center API doc: https://developer.atlassian.com/server/bitbucket/rest/v815/api-group-pull-requests/
+type AutoMergeRequest struct {
+	// StrategyID (optional) the merge strategy to use, e.g. MergeStrategySquash.
+	StrategyID string `json:"strategyId,omitempty"`
+
+	// Message (optional) the commit message of the merge commit.
+	Message string `json:"message,omitempty"`
+
+	// AutoSubject (optional) whether to prepend an auto generated subject to the message.
+	AutoSubject bool `json:"autoSubject,omitempty"`
+
+	// FromHash (optional) the commit expected at the source branch, the request is
+	// cancelled if the branch is updated.
+	FromHash string `json:"fromHash,omitempty"`
+
+	Creator     *User `json:"creator,omitempty"`
+	CreatedDate Time  `json:"createdDate,omitempty"`
+}
+
+// RequestAutoMerge requests the pull request to be merged automatically once it can be merged.
+func (s *PullRequestsService) RequestAutoMerge(ctx context.Context, projectKey, repo string, id int, request *AutoMergeRequest) (*AutoMergeRequest, *Response, error) {
+	if err := s.client.requireVersion(ctx, "auto-merge", 8, 15); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/auto-merge", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AutoMergeRequest)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// GetAutoMerge retrieves the auto-merge request of a pull request. The server responds
+// with 404 Not Found if auto-merge was not requested.
+func (s *PullRequestsService) GetAutoMerge(ctx context.Context, projectKey, repo string, id int) (*AutoMergeRequest, *Response, error) {
+	if err := s.client.requireVersion(ctx, "auto-merge", 8, 15); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/auto-merge", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AutoMergeRequest)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// CancelAutoMerge cancels the auto-merge request of a pull request.
+func (s *PullRequestsService) CancelAutoMerge(ctx context.Context, projectKey, repo string, id int) (*Response, error) {
+	if err := s.client.requireVersion(ctx, "auto-merge", 8, 15); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/auto-merge", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,173 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const (
+	ParticipantStatusApproved   = "APPROVED"
+	ParticipantStatusNeedsWork  = "NEEDS_WORK"
+	ParticipantStatusUnapproved = "UNAPPROVED"
+
+	ParticipantRoleAuthor      = "AUTHOR"
+	ParticipantRoleReviewer    = "REVIEWER"
+	ParticipantRoleParticipant = "PARTICIPANT"
+)
+
+// ParticipantStatus is the payload used to change the status of a pull request participant.
+type ParticipantStatus struct {
+	// Status the new status: APPROVED, NEEDS_WORK or UNAPPROVED.
+	Status string `json:"status"`
+
+	// LastReviewedCommit (optional) the commit the participant reviewed, it is
+	// used to track the changes made since the participant's last review.
+	LastReviewedCommit string `json:"lastReviewedCommit,omitempty"`
+}
+
+// UpdateParticipantStatus changes the status of the participant with the userSlug on
+// a pull request. The authenticated user can only change their own status, and will
+// be added as a participant if they are not one already.
+func (s *PullRequestsService) UpdateParticipantStatus(ctx context.Context, projectKey, repo string, id int, userSlug string, status *ParticipantStatus) (*PullRequestUser, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/participants/%s", projectKey, repo, id, url.PathEscape(userSlug))
+
+	req, err := s.client.NewRequest(ctx, "PUT", u, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participant := new(PullRequestUser)
+	resp, err := s.client.Do(req, participant)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return participant, resp, nil
+}
+
+// Approve marks the pull request as approved by the authenticated user. lastReviewedCommit is optional.
+//
+// It performs at least three requests: WhoAmI to know the current user, List to find
+// their slug, and the status update.
+func (s *PullRequestsService) Approve(ctx context.Context, projectKey, repo string, id int, lastReviewedCommit string) (*PullRequestUser, *Response, error) {
+	return s.setMyStatus(ctx, projectKey, repo, id, ParticipantStatusApproved, lastReviewedCommit)
+}
+
+// NeedsWork marks the pull request as needs work by the authenticated user. lastReviewedCommit is optional.
+//
+// It performs at least three requests: WhoAmI to know the current user, List to find
+// their slug, and the status update.
+func (s *PullRequestsService) NeedsWork(ctx context.Context, projectKey, repo string, id int, lastReviewedCommit string) (*PullRequestUser, *Response, error) {
+	return s.setMyStatus(ctx, projectKey, repo, id, ParticipantStatusNeedsWork, lastReviewedCommit)
+}
+
+// Unapprove removes the approval or needs work status of the authenticated user. lastReviewedCommit is optional.
+//
+// It performs at least three requests: WhoAmI to know the current user, List to find
+// their slug, and the status update.
+func (s *PullRequestsService) Unapprove(ctx context.Context, projectKey, repo string, id int, lastReviewedCommit string) (*PullRequestUser, *Response, error) {
+	return s.setMyStatus(ctx, projectKey, repo, id, ParticipantStatusUnapproved, lastReviewedCommit)
+}
+
+// setMyStatus finds the current user through WhoAmI method, which returns the username,
+// then looks the user up to get their slug, since they differ for usernames like
+// ci-bot@corp.com, and finally updates the user status.
+func (s *PullRequestsService) setMyStatus(ctx context.Context, projectKey, repo string, id int, status, lastReviewedCommit string) (*PullRequestUser, *Response, error) {
+	name, resp, err := s.client.Users.WhoAmI(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	user, resp, err := s.client.Users.findByName(ctx, name)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.UpdateParticipantStatus(ctx, projectKey, repo, id, user.Slug, &ParticipantStatus{
+		Status:             status,
+		LastReviewedCommit: lastReviewedCommit,
+	})
+}
+
+// ListParticipants retrieves a page of the participants of a pull request, including its
+// reviewers. The endpoint does not filter by role, use FilterParticipantsByRole to keep
+// only the participants with a given role.
+func (s *PullRequestsService) ListParticipants(ctx context.Context, projectKey, repo string, id int, opts *ListOptions) ([]*PullRequestUser, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/participants", projectKey, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var participants []*PullRequestUser
+	page := &pagedResponse{
+		Values: &participants,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return participants, resp, nil
+}
+
+// FilterParticipantsByRole returns the participants with the role, either AUTHOR,
+// REVIEWER or PARTICIPANT.
+func FilterParticipantsByRole(participants []*PullRequestUser, role string) []*PullRequestUser {
+	var filtered []*PullRequestUser
+	for _, p := range participants {
+		if p.Role == role {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
+
+// ListRepositoryParticipantsOptions specifies the optional parameters to the
+// PullRequestsService.ListRepositoryParticipants method.
+type ListRepositoryParticipantsOptions struct {
+	// Direction (optional, defaults to INCOMING) the direction of the pull requests
+	// relative to the repository. Either INCOMING or OUTGOING.
+	Direction string `url:"direction,omitempty"`
+
+	// Filter (optional) return only users, whose username, name or email address contain this value.
+	Filter string `url:"filter,omitempty"`
+
+	// Role (optional) return only users who have this role in the pull requests.
+	// Either AUTHOR, REVIEWER or PARTICIPANT.
+	Role string `url:"role,omitempty"`
+
+	ListOptions
+}
+
+// ListRepositoryParticipants retrieves a page of the users who participated in
+// pull requests to or from the specified repository.
+func (s *PullRequestsService) ListRepositoryParticipants(ctx context.Context, projectKey, repo string, opts *ListRepositoryParticipantsOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/participants", projectKey, repo)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	page := &pagedResponse{
+		Values: &users,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// RebaseCondition describes whether the source branch of a pull request can be rebased.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-git-rest.html
+type RebaseCondition struct {
+	CanRebase bool `json:"canRebase"`
+
+	// CanWrite whether the authenticated user can write to the source repository.
+	CanWrite bool `json:"canWrite"`
+
+	// Vetoes the reasons that prevent the rebase, if any.
+	Vetoes []*Veto `json:"vetoes,omitempty"`
+}
+
+// Veto represents a reason that prevents an operation, such as a rebase, from being performed.
+type Veto struct {
+	SummaryMessage  string `json:"summaryMessage,omitempty"`
+	DetailedMessage string `json:"detailedMessage,omitempty"`
+}
+
+type rebaseRequest struct {
+	Version int `json:"version"`
+}
+
+type rebaseResult struct {
+	Ref *PullRequestRef `json:"ref"`
+}
+
+// CanRebase checks whether the source branch of a pull request can be rebased on its target branch.
+func (s *PullRequestsService) CanRebase(ctx context.Context, projectKey, repo string, id int) (*RebaseCondition, *Response, error) {
+	u := restURL("git/1.0", fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/rebase", projectKey, repo, id))
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	condition := new(RebaseCondition)
+	resp, err := s.client.Do(req, condition)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return condition, resp, nil
+}
+
+// Rebase rebases the source branch of a pull request on its target branch and returns
+// the updated source ref. The version must match the current version of the pull request.
+func (s *PullRequestsService) Rebase(ctx context.Context, projectKey, repo string, id, version int) (*PullRequestRef, *Response, error) {
+	u := restURL("git/1.0", fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/rebase", projectKey, repo, id))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, &rebaseRequest{Version: version})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(rebaseResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Ref, resp, nil
+}
@@ -0,0 +1,206 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	MergeStrategyNoFastForward       = "no-ff"
+	MergeStrategyFastForward         = "ff"
+	MergeStrategyFastForwardOnly     = "ff-only"
+	MergeStrategySquash              = "squash"
+	MergeStrategySquashFastForward   = "squash-ff-only"
+	MergeStrategyRebaseNoFastForward = "rebase-no-ff"
+	MergeStrategyRebaseFastForward   = "rebase-ff-only"
+)
+
+// PullRequestSettings represents the pull request settings of a repository or a project.
+// The settings are updated as a whole, so retrieve them first and change the
+// needed fields before updating them.
+type PullRequestSettings struct {
+	MergeConfig              *MergeConfig `json:"mergeConfig,omitempty"`
+	RequiredAllApprovers     bool         `json:"requiredAllApprovers"`
+	RequiredAllTasksComplete bool         `json:"requiredAllTasksComplete"`
+	RequiredApprovers        int          `json:"requiredApprovers"`
+	RequiredSuccessfulBuilds int          `json:"requiredSuccessfulBuilds"`
+}
+
+// MergeConfig holds the merge strategies enabled for pull requests.
+type MergeConfig struct {
+	// DefaultStrategy the strategy selected by default when merging, only its ID is required.
+	DefaultStrategy *MergeStrategy `json:"defaultStrategy,omitempty"`
+
+	// Strategies the enabled strategies, only their IDs are required.
+	Strategies []*MergeStrategy `json:"strategies,omitempty"`
+
+	// Type the level the configuration is inherited from: DEFAULT, PROJECT or REPOSITORY.
+	Type string `json:"type,omitempty"`
+}
+
+type MergeStrategy struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Flag        string `json:"flag,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty"`
+}
+
+// AutoDeclineSettings represents the settings used to automatically decline inactive pull requests.
+type AutoDeclineSettings struct {
+	Enabled         bool   `json:"enabled"`
+	InactivityWeeks int    `json:"inactivityWeeks"` // either 1, 2, 4, 8 or 12
+	Scope           *Scope `json:"scope,omitempty"`
+}
+
+// GetSettings retrieves the pull request settings of a repository.
+func (s *PullRequestsService) GetSettings(ctx context.Context, projectKey, repositorySlug string) (*PullRequestSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/pull-requests", projectKey, repositorySlug)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(PullRequestSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// UpdateSettings updates the pull request settings of a repository.
+func (s *PullRequestsService) UpdateSettings(ctx context.Context, projectKey, repositorySlug string, settings *PullRequestSettings) (*PullRequestSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/pull-requests", projectKey, repositorySlug)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(PullRequestSettings)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// GetProjectSettings retrieves the pull request settings of a project for the
+// repositories of the given SCM (i.e., git).
+func (s *PullRequestsService) GetProjectSettings(ctx context.Context, projectKey, scmID string) (*PullRequestSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/pull-requests/%s", projectKey, scmID)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(PullRequestSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+// UpdateProjectSettings updates the pull request settings of a project for the
+// repositories of the given SCM (i.e., git).
+func (s *PullRequestsService) UpdateProjectSettings(ctx context.Context, projectKey, scmID string, settings *PullRequestSettings) (*PullRequestSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/pull-requests/%s", projectKey, scmID)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(PullRequestSettings)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+// GetAutoDeclineSettings retrieves the auto decline settings of a repository,
+// the settings are inherited from the project if the repository does not define them.
+func (s *PullRequestsService) GetAutoDeclineSettings(ctx context.Context, projectKey, repositorySlug string) (*AutoDeclineSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/auto-decline", projectKey, repositorySlug)
+	return s.getAutoDeclineSettings(ctx, u)
+}
+
+// UpdateAutoDeclineSettings creates or updates the auto decline settings of a repository.
+func (s *PullRequestsService) UpdateAutoDeclineSettings(ctx context.Context, projectKey, repositorySlug string, settings *AutoDeclineSettings) (*AutoDeclineSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/auto-decline", projectKey, repositorySlug)
+	return s.updateAutoDeclineSettings(ctx, u, settings)
+}
+
+// DeleteAutoDeclineSettings deletes the auto decline settings of a repository, so
+// the settings of its project are used instead.
+func (s *PullRequestsService) DeleteAutoDeclineSettings(ctx context.Context, projectKey, repositorySlug string) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/auto-decline", projectKey, repositorySlug)
+	return s.deleteAutoDeclineSettings(ctx, u)
+}
+
+// GetProjectAutoDeclineSettings retrieves the auto decline settings of a project.
+func (s *PullRequestsService) GetProjectAutoDeclineSettings(ctx context.Context, projectKey string) (*AutoDeclineSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/auto-decline", projectKey)
+	return s.getAutoDeclineSettings(ctx, u)
+}
+
+// UpdateProjectAutoDeclineSettings creates or updates the auto decline settings of a project.
+func (s *PullRequestsService) UpdateProjectAutoDeclineSettings(ctx context.Context, projectKey string, settings *AutoDeclineSettings) (*AutoDeclineSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/auto-decline", projectKey)
+	return s.updateAutoDeclineSettings(ctx, u, settings)
+}
+
+// DeleteProjectAutoDeclineSettings deletes the auto decline settings of a project,
+// so the global default settings are used instead.
+func (s *PullRequestsService) DeleteProjectAutoDeclineSettings(ctx context.Context, projectKey string) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/settings/auto-decline", projectKey)
+	return s.deleteAutoDeclineSettings(ctx, u)
+}
+
+func (s *PullRequestsService) getAutoDeclineSettings(ctx context.Context, u string) (*AutoDeclineSettings, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(AutoDeclineSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return settings, resp, nil
+}
+
+func (s *PullRequestsService) updateAutoDeclineSettings(ctx context.Context, u string, settings *AutoDeclineSettings) (*AutoDeclineSettings, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PUT", u, settings)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(AutoDeclineSettings)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+func (s *PullRequestsService) deleteAutoDeclineSettings(ctx context.Context, u string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
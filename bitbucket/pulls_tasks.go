@@ -0,0 +1,255 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	TaskStateOpen     = "OPEN"
+	TaskStateResolved = "RESOLVED"
+)
+
+// Task represents a pull request task. Since Bitbucket Server 7.2 tasks are comments
+// with BLOCKER severity, on older servers they are separate entities anchored to comments.
+type Task struct {
+	ID          int    `json:"id,omitempty"`
+	Version     int    `json:"version,omitempty"` // this populated only for blocker comments
+	Text        string `json:"text,omitempty"`
+	State       string `json:"state,omitempty"`
+	Author      *User  `json:"author,omitempty"`
+	CreatedDate Time   `json:"createdDate,omitempty"`
+}
+
+// TaskCount holds the number of open and resolved tasks of a pull request.
+type TaskCount struct {
+	Open     int `json:"open"`
+	Resolved int `json:"resolved"`
+}
+
+// legacyTask is the payload used by the `tasks` endpoints of servers older than 7.2.
+type legacyTask struct {
+	ID     int               `json:"id,omitempty"`
+	Text   string            `json:"text,omitempty"`
+	State  string            `json:"state,omitempty"`
+	Anchor *legacyTaskAnchor `json:"anchor,omitempty"`
+}
+
+type legacyTaskAnchor struct {
+	ID   int    `json:"id"`
+	Type string `json:"type"`
+}
+
+func taskFromComment(c *Comment) *Task {
+	return &Task{
+		ID:          c.ID,
+		Version:     c.Version,
+		Text:        c.Text,
+		State:       c.State,
+		Author:      c.Author,
+		CreatedDate: c.CreatedDate,
+	}
+}
+
+// useBlockerComments reports whether the server represents tasks as blocker comments.
+func (s *PullRequestsService) useBlockerComments(ctx context.Context) (bool, error) {
+	return s.client.serverVersionAtLeast(ctx, 7, 2)
+}
+
+// CreateTask adds a task to a pull request. On servers with blocker comments, the task
+// is a reply to the comment with commentID, or a top-level task if commentID is zero.
+// On older servers, tasks must be anchored to a comment, so commentID is required.
+func (s *PullRequestsService) CreateTask(ctx context.Context, projectKey, repo string, id, commentID int, text string) (*Task, *Response, error) {
+	blocker, err := s.useBlockerComments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if blocker {
+		comment := &Comment{Text: text, Severity: CommentSeverityBlocker}
+		if commentID != 0 {
+			comment.Parent = &Comment{ID: commentID}
+		}
+
+		c, resp, err := s.CreateComment(ctx, projectKey, repo, id, comment)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		return taskFromComment(c), resp, nil
+	}
+
+	if commentID == 0 {
+		return nil, nil, errors.New("tasks must be anchored to a comment on Bitbucket Server versions older than 7.2")
+	}
+
+	body := &legacyTask{
+		Text:   text,
+		Anchor: &legacyTaskAnchor{ID: commentID, Type: "COMMENT"},
+	}
+	req, err := s.client.NewRequest(ctx, "POST", "tasks", body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return task, resp, nil
+}
+
+// ListTasks retrieves a page of the tasks of a pull request.
+func (s *PullRequestsService) ListTasks(ctx context.Context, projectKey, repo string, id int, opts *ListOptions) ([]*Task, *Response, error) {
+	blocker, err := s.useBlockerComments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/tasks", projectKey, repo, id)
+	if blocker {
+		u = fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/blocker-comments", projectKey, repo, id)
+	}
+	u, err = addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !blocker {
+		var tasks []*Task
+		page := &pagedResponse{
+			Values: &tasks,
+		}
+		resp, err := s.client.Do(req, page)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		return tasks, resp, nil
+	}
+
+	var comments []*Comment
+	page := &pagedResponse{
+		Values: &comments,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	tasks := make([]*Task, len(comments))
+	for i, c := range comments {
+		tasks[i] = taskFromComment(c)
+	}
+
+	return tasks, resp, nil
+}
+
+// ResolveTask marks a task as resolved. On servers with blocker comments, the task
+// Version must match the current version of the blocker comment.
+func (s *PullRequestsService) ResolveTask(ctx context.Context, projectKey, repo string, id int, task *Task) (*Task, *Response, error) {
+	return s.setTaskState(ctx, projectKey, repo, id, task, TaskStateResolved)
+}
+
+// ReopenTask marks a resolved task as open. On servers with blocker comments, the task
+// Version must match the current version of the blocker comment.
+func (s *PullRequestsService) ReopenTask(ctx context.Context, projectKey, repo string, id int, task *Task) (*Task, *Response, error) {
+	return s.setTaskState(ctx, projectKey, repo, id, task, TaskStateOpen)
+}
+
+func (s *PullRequestsService) setTaskState(ctx context.Context, projectKey, repo string, id int, task *Task, state string) (*Task, *Response, error) {
+	blocker, err := s.useBlockerComments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if blocker {
+		c, resp, err := s.updateComment(ctx, projectKey, repo, id, task.ID, &commentUpdate{Version: task.Version, State: state})
+		if err != nil {
+			return nil, resp, err
+		}
+
+		return taskFromComment(c), resp, nil
+	}
+
+	u := fmt.Sprintf("tasks/%v", task.ID)
+	req, err := s.client.NewRequest(ctx, "PUT", u, &legacyTask{ID: task.ID, State: state})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Task)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// DeleteTask deletes a task. On servers with blocker comments, the task Version must
+// match the current version of the blocker comment.
+func (s *PullRequestsService) DeleteTask(ctx context.Context, projectKey, repo string, id int, task *Task) (*Response, error) {
+	blocker, err := s.useBlockerComments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if blocker {
+		return s.DeleteComment(ctx, projectKey, repo, id, task.ID, task.Version)
+	}
+
+	u := fmt.Sprintf("tasks/%v", task.ID)
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// CountTasks retrieves the number of open and resolved tasks of a pull request.
+func (s *PullRequestsService) CountTasks(ctx context.Context, projectKey, repo string, id int) (*TaskCount, *Response, error) {
+	blocker, err := s.useBlockerComments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if blocker {
+		// the tasks count endpoint is deprecated since 7.2 and removed in 8.0, but
+		// the counts are still part of the pull request properties.
+		pull, resp, err := s.Get(ctx, projectKey, repo, id)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		count := new(TaskCount)
+		if pull.Properties != nil {
+			count.Open = pull.Properties.OpenTaskCount
+			count.Resolved = pull.Properties.ResolvedTaskCount
+		}
+		return count, resp, nil
+	}
+
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/tasks/count", projectKey, repo, id)
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	count := new(TaskCount)
+	resp, err := s.client.Do(req, count)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return count, resp, nil
+}
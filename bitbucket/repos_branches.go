@@ -0,0 +1,172 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	BranchTypeBugfix  = "BUGFIX"
+	BranchTypeFeature = "FEATURE"
+	BranchTypeHotfix  = "HOTFIX"
+	BranchTypeRelease = "RELEASE"
+)
+
+// BranchModel represents the effective branching model of a repository.
+//
+// Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-branch-rest.html
+type BranchModel struct {
+	Development *Branch       `json:"development,omitempty"`
+	Production  *Branch       `json:"production,omitempty"` // this populated only if the production branch is configured
+	Types       []*BranchType `json:"types,omitempty"`
+}
+
+// BranchType represents a category of branches identified by a prefix, e.g. feature/.
+type BranchType struct {
+	// ID either BUGFIX, FEATURE, HOTFIX or RELEASE.
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName,omitempty"`
+	Prefix      string `json:"prefix,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// BranchModelConfiguration represents the branching model configuration of a
+// repository or a project.
+type BranchModelConfiguration struct {
+	Development *BranchModelRef `json:"development"`
+	Production  *BranchModelRef `json:"production,omitempty"`
+	Types       []*BranchType   `json:"types"`
+}
+
+// BranchModelRef configures the development or the production branch of a branching model.
+type BranchModelRef struct {
+	// RefID (optional) the branch ID, e.g. refs/heads/develop.
+	RefID string `json:"refId,omitempty"`
+
+	// UseDefault whether to use the default branch of the repository instead of RefID.
+	UseDefault bool `json:"useDefault"`
+}
+
+// CreateBranchRequest is the payload used to create a branch.
+type CreateBranchRequest struct {
+	// Name the name of the branch, e.g. feature/JIRA-123.
+	Name string `json:"name"`
+
+	// StartPoint the commit ID or the ref to create the branch from.
+	StartPoint string `json:"startPoint"`
+
+	// Message (optional) the message of the tag, if the branch is created from an annotated tag.
+	Message string `json:"message,omitempty"`
+}
+
+// CreateBranch creates a branch in a repository.
+func (s *RepositoriesService) CreateBranch(ctx context.Context, projectKey, repositorySlug string, branch *CreateBranchRequest) (*Branch, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/repos/%s/branches", projectKey, repositorySlug))
+
+	req, err := s.client.NewRequest(ctx, "POST", u, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(Branch)
+	resp, err := s.client.Do(req, b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b, resp, nil
+}
+
+// CreateBranchOfType creates a branch whose name is the prefix of the branchType, as
+// configured in the branching model of the repository, followed by the name.
+// E.g., the branch feature/JIRA-123 is created for FEATURE type and JIRA-123 name.
+func (s *RepositoriesService) CreateBranchOfType(ctx context.Context, projectKey, repositorySlug, branchType, name, startPoint string) (*Branch, *Response, error) {
+	model, resp, err := s.GetBranchModel(ctx, projectKey, repositorySlug)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, t := range model.Types {
+		if t.ID == branchType {
+			return s.CreateBranch(ctx, projectKey, repositorySlug, &CreateBranchRequest{
+				Name:       t.Prefix + name,
+				StartPoint: startPoint,
+			})
+		}
+	}
+
+	return nil, resp, fmt.Errorf("branch type %s is not enabled in the branching model of %s/%s", branchType, projectKey, repositorySlug)
+}
+
+// GetBranchModel retrieves the effective branching model of a repository.
+func (s *RepositoriesService) GetBranchModel(ctx context.Context, projectKey, repositorySlug string) (*BranchModel, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/repos/%s/branchmodel", projectKey, repositorySlug))
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	model := new(BranchModel)
+	resp, err := s.client.Do(req, model)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return model, resp, nil
+}
+
+// GetBranchModelConfiguration retrieves the branching model configuration of a repository.
+func (s *RepositoriesService) GetBranchModelConfiguration(ctx context.Context, projectKey, repositorySlug string) (*BranchModelConfiguration, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/repos/%s/branchmodel/configuration", projectKey, repositorySlug))
+	return s.getBranchModelConfiguration(ctx, u)
+}
+
+// UpdateBranchModelConfiguration updates the branching model configuration of a repository.
+func (s *RepositoriesService) UpdateBranchModelConfiguration(ctx context.Context, projectKey, repositorySlug string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/repos/%s/branchmodel/configuration", projectKey, repositorySlug))
+	return s.updateBranchModelConfiguration(ctx, u, config)
+}
+
+// GetProjectBranchModelConfiguration retrieves the branching model configuration of a project.
+func (s *RepositoriesService) GetProjectBranchModelConfiguration(ctx context.Context, projectKey string) (*BranchModelConfiguration, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/branchmodel/configuration", projectKey))
+	return s.getBranchModelConfiguration(ctx, u)
+}
+
+// UpdateProjectBranchModelConfiguration updates the branching model configuration of
+// a project, it applies to the repositories that do not override it.
+func (s *RepositoriesService) UpdateProjectBranchModelConfiguration(ctx context.Context, projectKey string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	u := restURL("branch-utils/1.0", fmt.Sprintf("projects/%s/branchmodel/configuration", projectKey))
+	return s.updateBranchModelConfiguration(ctx, u, config)
+}
+
+func (s *RepositoriesService) getBranchModelConfiguration(ctx context.Context, u string) (*BranchModelConfiguration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	config := new(BranchModelConfiguration)
+	resp, err := s.client.Do(req, config)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return config, resp, nil
+}
+
+func (s *RepositoriesService) updateBranchModelConfiguration(ctx context.Context, u string, config *BranchModelConfiguration) (*BranchModelConfiguration, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PUT", u, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(BranchModelConfiguration)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
@@ -0,0 +1,87 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListCommitsOptions specifies the optional parameters to the RepositoriesService.ListCommits method.
+type ListCommitsOptions struct {
+	// Until (optional, defaults to the default branch) the commit ID or the ref to list
+	// the commits reachable from, e.g. refs/heads/master.
+	Until string `url:"until,omitempty"`
+
+	// Since (optional) exclude the commits reachable from this commit ID or ref.
+	Since string `url:"since,omitempty"`
+
+	// Path (optional) return only the commits that modified this path.
+	Path string `url:"path,omitempty"`
+
+	// Merges (optional, defaults to include) either include, exclude or only.
+	Merges string `url:"merges,omitempty"`
+
+	ListOptions
+}
+
+// ListCommits retrieves a page of the commits in a repository, newest first.
+func (s *RepositoriesService) ListCommits(ctx context.Context, projectKey, repositorySlug string, opts *ListCommitsOptions) ([]*Commit, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/commits", projectKey, repositorySlug)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var commits []*Commit
+	page := &pagedResponse{
+		Values: &commits,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return commits, resp, nil
+}
+
+// GetCommit retrieves a commit of a repository.
+func (s *RepositoriesService) GetCommit(ctx context.Context, projectKey, repositorySlug, commitID string) (*Commit, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/commits/%s", projectKey, repositorySlug, commitID)
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(Commit)
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return commit, resp, nil
+}
+
+// ListUnverifiedCommits retrieves a page of commits, like ListCommits, and returns
+// only those that are either unsigned or whose signature is not verified. The page
+// may hold fewer commits than the limit, or none at all, so callers should paginate
+// through the returned Response and bound the history with Since.
+func (s *RepositoriesService) ListUnverifiedCommits(ctx context.Context, projectKey, repositorySlug string, opts *ListCommitsOptions) ([]*Commit, *Response, error) {
+	commits, resp, err := s.ListCommits(ctx, projectKey, repositorySlug, opts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var unverified []*Commit
+	for _, c := range commits {
+		if !c.Verified() {
+			unverified = append(unverified, c)
+		}
+	}
+
+	return unverified, resp, nil
+}
@@ -5,36 +5,95 @@ import (
 	"fmt"
 )
 
-type WebHook struct {
-	ID            int                  `json:"id,omitempty"`
-	Name          string               `json:"name,omitempty"`
-	CreatedDate   Time                 `json:"createdDate,omitempty"`
-	UpdatedDate   Time                 `json:"updatedDate,omitempty"`
-	Events        []string             `json:"events,omitempty"`
-	Configuration WebHookConfiguration `json:"configuration,omitempty"`
-	Url           string               `json:"url,omitempty"`
-	Active        bool                 `json:"active,omitempty"`
+const (
+	HookTypePreReceive          = "PRE_RECEIVE"
+	HookTypePostReceive         = "POST_RECEIVE"
+	HookTypePrePullRequestMerge = "PRE_PULL_REQUEST_MERGE"
+)
+
+// Hook represents a repository hook, or a merge check, and its state in a
+// repository or a project.
+type Hook struct {
+	Details    *HookDetails `json:"details,omitempty"`
+	Enabled    bool         `json:"enabled"`
+	Configured bool         `json:"configured"`
+	Scope      *Scope       `json:"scope,omitempty"` // the project or the repository the state is inherited from
 }
 
-type WebHookConfiguration struct {
-	Secret string `json:"secret,omitempty"`
+// HookDetails describes a hook provided by an app.
+type HookDetails struct {
+	Key             string   `json:"key,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Type            string   `json:"type,omitempty"` // either PRE_RECEIVE, POST_RECEIVE or PRE_PULL_REQUEST_MERGE
+	Description     string   `json:"description,omitempty"`
+	Version         string   `json:"version,omitempty"`
+	ConfigFormKey   string   `json:"configFormKey,omitempty"`
+	SupportedScopes []string `json:"supportedScopes,omitempty"`
 }
 
-type WebHookListOptions struct {
-	Event      string `url:"event,omitempty"`
-	Statistics bool   `url:"statistics,omitempty"`
+// HookSettings holds the settings of a hook, their structure is defined by the hook itself.
+type HookSettings map[string]interface{}
+
+// ListHooksOptions specifies the optional parameters to the methods that list hooks.
+type ListHooksOptions struct {
+	// Type (optional) return only the hooks of this type, either PRE_RECEIVE,
+	// POST_RECEIVE or PRE_PULL_REQUEST_MERGE.
+	Type string `url:"type,omitempty"`
+
+	ListOptions
 }
 
-func (s *RepositoriesService) CreateWebHooks(ctx context.Context, projectKey, repositorySlug string, hook *WebHook) (*WebHook, *Response, error) {
-	u := fmt.Sprintf("projects/%s/repos/%s/webhooks", projectKey, repositorySlug)
+func listHooks(ctx context.Context, c *Client, u string, opts *ListHooksOptions) ([]*Hook, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	req, err := s.client.NewRequest(ctx, "POST", u, hook)
+	req, err := c.NewRequest(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	v := new(WebHook)
-	resp, err := s.client.Do(req, v)
+	var hooks []*Hook
+	page := &pagedResponse{
+		Values: &hooks,
+	}
+	resp, err := c.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hooks, resp, nil
+}
+
+func doHook(ctx context.Context, c *Client, method, u string) (*Hook, *Response, error) {
+	req, err := c.NewRequest(ctx, method, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(Hook)
+	resp, err := c.Do(req, hook)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hook, resp, nil
+}
+
+func doHookSettings(ctx context.Context, c *Client, method, u string, settings HookSettings) (HookSettings, *Response, error) {
+	var body interface{}
+	if settings != nil {
+		body = settings
+	}
+
+	req, err := c.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := HookSettings{}
+	resp, err := c.Do(req, &v)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -42,17 +101,205 @@ func (s *RepositoriesService) CreateWebHooks(ctx context.Context, projectKey, re
 	return v, resp, nil
 }
 
+// ListHooks retrieves a page of the hooks of a repository.
+func (s *RepositoriesService) ListHooks(ctx context.Context, projectKey, repositorySlug string, opts *ListHooksOptions) ([]*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks", projectKey, repositorySlug)
+	return listHooks(ctx, s.client, u, opts)
+}
+
+// GetHook retrieves a hook of a repository.
+func (s *RepositoriesService) GetHook(ctx context.Context, projectKey, repositorySlug, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks/%s", projectKey, repositorySlug, hookKey)
+	return doHook(ctx, s.client, "GET", u)
+}
+
+// EnableHook enables a hook in a repository, using its current settings.
+func (s *RepositoriesService) EnableHook(ctx context.Context, projectKey, repositorySlug, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks/%s/enabled", projectKey, repositorySlug, hookKey)
+	return doHook(ctx, s.client, "PUT", u)
+}
+
+// DisableHook disables a hook in a repository.
+func (s *RepositoriesService) DisableHook(ctx context.Context, projectKey, repositorySlug, hookKey string) (*Hook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks/%s/enabled", projectKey, repositorySlug, hookKey)
+	return doHook(ctx, s.client, "DELETE", u)
+}
+
+// GetHookSettings retrieves the settings of a hook in a repository.
+func (s *RepositoriesService) GetHookSettings(ctx context.Context, projectKey, repositorySlug, hookKey string) (HookSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks/%s/settings", projectKey, repositorySlug, hookKey)
+	return doHookSettings(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateHookSettings replaces the settings of a hook in a repository.
+func (s *RepositoriesService) UpdateHookSettings(ctx context.Context, projectKey, repositorySlug, hookKey string, settings HookSettings) (HookSettings, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/settings/hooks/%s/settings", projectKey, repositorySlug, hookKey)
+	return doHookSettings(ctx, s.client, "PUT", u, settings)
+}
+
+type WebHook struct {
+	ID                      int                  `json:"id,omitempty"`
+	Name                    string               `json:"name,omitempty"`
+	CreatedDate             Time                 `json:"createdDate,omitempty"`
+	UpdatedDate             Time                 `json:"updatedDate,omitempty"`
+	Events                  []string             `json:"events,omitempty"`
+	Configuration           WebHookConfiguration `json:"configuration,omitempty"`
+	Url                     string               `json:"url,omitempty"`
+	Active                  *bool                `json:"active,omitempty"`
+	ScopeType               string               `json:"scopeType,omitempty"`               // either PROJECT or REPOSITORY
+	SslVerificationRequired *bool                `json:"sslVerificationRequired,omitempty"` // defaults to true
+	Credentials             *WebHookCredentials  `json:"credentials,omitempty"`
+
+	// Statistics this populated only when the statistics are requested.
+	Statistics *WebHookStatistics `json:"statistics,omitempty"`
+}
+
+type WebHookConfiguration struct {
+	Secret    string `json:"secret,omitempty"`
+	CreatedBy string `json:"createdBy,omitempty"` // e.g. jira for the webhooks created by Jira
+}
+
+// WebHookCredentials the basic authentication credentials sent with the webhook requests.
+type WebHookCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// WebHookStatistics holds the latest invocations of a webhook by their result.
+type WebHookStatistics struct {
+	LastSuccess *WebHookInvocation `json:"lastSuccess,omitempty"`
+	LastFailure *WebHookInvocation `json:"lastFailure,omitempty"` // the target responded with an error status
+	LastError   *WebHookInvocation `json:"lastError,omitempty"`   // the target could not be reached
+}
+
+// WebHookInvocation represents a single delivery of an event to a webhook.
+type WebHookInvocation struct {
+	ID         int                      `json:"id,omitempty"`
+	Event      string                   `json:"event,omitempty"`
+	EventScope *Scope                   `json:"eventScope,omitempty"`
+	Start      Time                     `json:"start,omitempty"`
+	Finish     Time                     `json:"finish,omitempty"`
+	Duration   int64                    `json:"duration,omitempty"` // in milliseconds
+	Request    *WebHookRequestInfo      `json:"request,omitempty"`
+	Result     *WebHookInvocationResult `json:"result,omitempty"`
+}
+
+// WebHookRequestInfo describes the request sent to the target of a webhook.
+type WebHookRequestInfo struct {
+	URL    string `json:"url,omitempty"`
+	Method string `json:"method,omitempty"`
+}
+
+// WebHookInvocationResult describes the result of a webhook invocation, e.g. the
+// status code or the error message.
+type WebHookInvocationResult struct {
+	Description string `json:"description,omitempty"`
+	Outcome     string `json:"outcome,omitempty"` // either SUCCESS, FAILURE or ERROR
+}
+
+// WebHookTestResult holds the request sent to a webhook target by a connection
+// test and the response received.
+type WebHookTestResult struct {
+	Request  *WebHookTestRequest  `json:"request,omitempty"`
+	Response *WebHookTestResponse `json:"response,omitempty"` // this nil if the target could not be reached
+}
+
+type WebHookTestRequest struct {
+	URL     string            `json:"url,omitempty"`
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+type WebHookTestResponse struct {
+	StatusCode int               `json:"statusCode,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+type WebHookListOptions struct {
+	Event      string `url:"event,omitempty"`
+	Statistics bool   `url:"statistics,omitempty"`
+}
+
+// WebHookOptions specifies the optional parameters to the methods that get a webhook.
+type WebHookOptions struct {
+	// Statistics (optional, defaults to false) if true, the statistics of the webhook are included.
+	Statistics bool `url:"statistics,omitempty"`
+}
+
+// TestWebHookOptions specifies the parameters to the methods that test a webhook connection.
+type TestWebHookOptions struct {
+	// URL the target to test.
+	URL string `url:"url"`
+
+	// SslVerificationRequired (optional, defaults to true) whether to verify the
+	// certificate of the target.
+	SslVerificationRequired *bool `url:"sslVerificationRequired,omitempty"`
+}
+
+func (s *RepositoriesService) CreateWebHooks(ctx context.Context, projectKey, repositorySlug string, hook *WebHook) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks", projectKey, repositorySlug)
+	return doWebHook(ctx, s.client, "POST", u, hook)
+}
+
 // ListWebHooks finds web hooks in a repository.
 //
 // Bitbucket Server API doc: https://docs.atlassian.com/bitbucket-server/rest/7.0.1/bitbucket-rest.html#idp365
 func (s *RepositoriesService) ListWebHooks(ctx context.Context, projectKey, repositorySlug string, opts *WebHookListOptions) ([]*WebHook, *Response, error) {
 	u := fmt.Sprintf("projects/%s/repos/%s/webhooks", projectKey, repositorySlug)
+	return listWebHooks(ctx, s.client, u, opts)
+}
+
+// GetWebHook retrieves a webhook of a repository.
+func (s *RepositoriesService) GetWebHook(ctx context.Context, projectKey, repositorySlug string, id int, opts *WebHookOptions) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks/%v", projectKey, repositorySlug, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return doWebHook(ctx, s.client, "GET", u, nil)
+}
+
+// UpdateWebHook updates a webhook of a repository.
+func (s *RepositoriesService) UpdateWebHook(ctx context.Context, projectKey, repositorySlug string, id int, hook *WebHook) (*WebHook, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks/%v", projectKey, repositorySlug, id)
+	return doWebHook(ctx, s.client, "PUT", u, hook)
+}
+
+// DeleteWebHook deletes a webhook of a repository.
+func (s *RepositoriesService) DeleteWebHook(ctx context.Context, projectKey, repositorySlug string, id int) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks/%v", projectKey, repositorySlug, id)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// GetWebHookStatistics retrieves the latest invocations of a webhook of a repository.
+func (s *RepositoriesService) GetWebHookStatistics(ctx context.Context, projectKey, repositorySlug string, id int) (*WebHookStatistics, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks/%v/statistics", projectKey, repositorySlug, id)
+	return getWebHookStatistics(ctx, s.client, u)
+}
+
+// TestWebHook tests the connection of a repository to a webhook target, and
+// returns the request sent and the response received.
+func (s *RepositoriesService) TestWebHook(ctx context.Context, projectKey, repositorySlug string, opts *TestWebHookOptions) (*WebHookTestResult, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/webhooks/test", projectKey, repositorySlug)
+	return testWebHook(ctx, s.client, u, opts)
+}
+
+func listWebHooks(ctx context.Context, c *Client, u string, opts *WebHookListOptions) ([]*WebHook, *Response, error) {
 	u, err := addOptions(u, opts)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	req, err := c.NewRequest(ctx, "GET", u, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -61,10 +308,65 @@ func (s *RepositoriesService) ListWebHooks(ctx context.Context, projectKey, repo
 	page := &pagedResponse{
 		Values: &hooks,
 	}
-	resp, err := s.client.Do(req, page)
+	resp, err := c.Do(req, page)
 	if err != nil {
 		return nil, resp, err
 	}
 
 	return hooks, resp, nil
 }
+
+func doWebHook(ctx context.Context, c *Client, method, u string, hook *WebHook) (*WebHook, *Response, error) {
+	var body interface{}
+	if hook != nil {
+		body = hook
+	}
+
+	req, err := c.NewRequest(ctx, method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(WebHook)
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return v, resp, nil
+}
+
+func getWebHookStatistics(ctx context.Context, c *Client, u string) (*WebHookStatistics, *Response, error) {
+	req, err := c.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats := new(WebHookStatistics)
+	resp, err := c.Do(req, stats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return stats, resp, nil
+}
+
+func testWebHook(ctx context.Context, c *Client, u string, opts *TestWebHookOptions) (*WebHookTestResult, *Response, error) {
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.NewRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WebHookTestResult)
+	resp, err := c.Do(req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
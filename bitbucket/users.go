@@ -3,9 +3,11 @@ package bitbucket
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"net/url"
+	"strings"
 )
 
 // UsersService handles communication with the user related
@@ -154,3 +156,37 @@ func (s *UsersService) List(ctx context.Context, opts *ListUsersOptions) ([]*Use
 
 	return users, resp, nil
 }
+
+var errEmptyUserName = errors.New("user name is empty, the client may not be authenticated")
+
+// sameUserName reports whether a and b are the same username, usernames are not case sensitive.
+func sameUserName(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// findByName goes through the pages of List filtered by the name to find the
+// user whose username matches it.
+func (s *UsersService) findByName(ctx context.Context, name string) (*User, *Response, error) {
+	if name == "" {
+		return nil, nil, errEmptyUserName
+	}
+
+	opts := &ListUsersOptions{Filter: name}
+	for {
+		users, resp, err := s.List(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, user := range users {
+			if sameUserName(user.Name, name) {
+				return user, resp, nil
+			}
+		}
+
+		if resp.IsLastPage {
+			return nil, resp, fmt.Errorf("user %s not found", name)
+		}
+		opts.Start = resp.NextPageStart
+	}
+}
@@ -0,0 +1,219 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// DetailedUser represents a user with the details visible only to administrators.
+type DetailedUser struct {
+	User
+
+	// DirectoryName the name of the user directory the user comes from, e.g. Bitbucket Internal Directory.
+	DirectoryName               string `json:"directoryName,omitempty"`
+	Deletable                   bool   `json:"deletable,omitempty"`
+	MutableDetails              bool   `json:"mutableDetails,omitempty"`
+	MutableGroups               bool   `json:"mutableGroups,omitempty"`
+	LastAuthenticationTimestamp Time   `json:"lastAuthenticationTimestamp,omitempty"`
+}
+
+// CreateUserOptions specifies the parameters to the UsersService.Create method.
+type CreateUserOptions struct {
+	// Name the username of the user.
+	Name string `url:"name"`
+
+	DisplayName  string `url:"displayName"`
+	EmailAddress string `url:"emailAddress"`
+
+	// Password (optional) the password of the user, it must be omitted when Notify is true.
+	Password string `url:"password,omitempty"`
+
+	// AddToDefaultGroup (optional, defaults to true) whether to add the user to the default group.
+	AddToDefaultGroup *bool `url:"addToDefaultGroup,omitempty"`
+
+	// Notify (optional, defaults to false) if true, an email is sent to the user to set their password.
+	Notify bool `url:"notify,omitempty"`
+}
+
+// Create creates a user. It requires ADMIN permission.
+func (s *UsersService) Create(ctx context.Context, opts *CreateUserOptions) (*Response, error) {
+	u, err := addOptions("admin/users", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// UserDetails is the payload used to update the details of a user.
+type UserDetails struct {
+	// Name the username of the user to update.
+	Name string `json:"name"`
+
+	// DisplayName (optional) the new display name.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// EmailAddress (optional) the new email address.
+	EmailAddress string `json:"email,omitempty"`
+}
+
+// Update updates the display name and the email address of a user. It requires ADMIN permission.
+func (s *UsersService) Update(ctx context.Context, details *UserDetails) (*DetailedUser, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "PUT", "admin/users", details)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(DetailedUser)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+type userRename struct {
+	Name    string `json:"name"`
+	NewName string `json:"newName"`
+}
+
+// Rename changes the username of a user. It requires ADMIN permission.
+func (s *UsersService) Rename(ctx context.Context, name, newName string) (*DetailedUser, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "admin/users/rename", &userRename{Name: name, NewName: newName})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(DetailedUser)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+type userNameOptions struct {
+	Name string `url:"name"`
+}
+
+// Delete deletes a user and returns it. It requires ADMIN permission.
+func (s *UsersService) Delete(ctx context.Context, name string) (*DetailedUser, *Response, error) {
+	u, err := addOptions("admin/users", &userNameOptions{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(DetailedUser)
+	resp, err := s.client.Do(req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+type userCredentials struct {
+	Name            string `json:"name"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"passwordConfirm"`
+}
+
+// ChangePassword changes the password of a user. It requires ADMIN permission.
+func (s *UsersService) ChangePassword(ctx context.Context, name, password string) (*Response, error) {
+	body := &userCredentials{Name: name, Password: password, PasswordConfirm: password}
+	req, err := s.client.NewRequest(ctx, "PUT", "admin/users/credentials", body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ClearCaptcha clears the CAPTCHA challenge required after failed login attempts
+// of a user. It requires ADMIN permission.
+func (s *UsersService) ClearCaptcha(ctx context.Context, name string) (*Response, error) {
+	u, err := addOptions("admin/users/captcha", &userNameOptions{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListDetailedOptions specifies the optional parameters to the UsersService.ListDetailed method.
+type ListDetailedOptions struct {
+	// Filter (optional) return only users, whose username, name or email address contain this value.
+	Filter string `url:"filter,omitempty"`
+
+	ListOptions
+}
+
+// ListDetailed retrieves a page of users with their administrative details, such as
+// the user directory. It requires ADMIN permission.
+func (s *UsersService) ListDetailed(ctx context.Context, opts *ListDetailedOptions) ([]*DetailedUser, *Response, error) {
+	u, err := addOptions("admin/users", opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*DetailedUser
+	page := &pagedResponse{
+		Values: &users,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// GetDetailed retrieves the user with the name and its administrative details. It
+// goes through the pages of ListDetailed filtered by the name to find the user whose
+// username matches it, usernames are not case sensitive.
+func (s *UsersService) GetDetailed(ctx context.Context, name string) (*DetailedUser, *Response, error) {
+	if name == "" {
+		return nil, nil, errEmptyUserName
+	}
+
+	opts := &ListDetailedOptions{Filter: name}
+	for {
+		users, resp, err := s.ListDetailed(ctx, opts)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, user := range users {
+			if sameUserName(user.Name, name) {
+				return user, resp, nil
+			}
+		}
+
+		if resp.IsLastPage {
+			return nil, resp, fmt.Errorf("user %s not found", name)
+		}
+		opts.Start = resp.NextPageStart
+	}
+}
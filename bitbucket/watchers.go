@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+)
+
+// Watch adds the authenticated user as a watcher of a pull request.
+func (s *PullRequestsService) Watch(ctx context.Context, projectKey, repo string, id int) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/watch", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Unwatch removes the authenticated user from the watchers of a pull request.
+func (s *PullRequestsService) Unwatch(ctx context.Context, projectKey, repo string, id int) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/watch", projectKey, repo, id)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListWatchers retrieves a page of the users watching a pull request.
+func (s *PullRequestsService) ListWatchers(ctx context.Context, projectKey, repo string, id int, opts *ListOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/pull-requests/%v/watchers", projectKey, repo, id)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	page := &pagedResponse{
+		Values: &users,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// Watch adds the authenticated user as a watcher of a repository.
+func (s *RepositoriesService) Watch(ctx context.Context, projectKey, repositorySlug string) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/watch", projectKey, repositorySlug)
+
+	req, err := s.client.NewRequest(ctx, "POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// Unwatch removes the authenticated user from the watchers of a repository.
+func (s *RepositoriesService) Unwatch(ctx context.Context, projectKey, repositorySlug string) (*Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/watch", projectKey, repositorySlug)
+
+	req, err := s.client.NewRequest(ctx, "DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListWatchers retrieves a page of the users watching a repository.
+func (s *RepositoriesService) ListWatchers(ctx context.Context, projectKey, repositorySlug string, opts *ListOptions) ([]*User, *Response, error) {
+	u := fmt.Sprintf("projects/%s/repos/%s/watchers", projectKey, repositorySlug)
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*User
+	page := &pagedResponse{
+		Values: &users,
+	}
+	resp, err := s.client.Do(req, page)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}